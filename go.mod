@@ -1,3 +1,3 @@
 module github.com/amey-tech/learn-go
 
-go 1.18
+go 1.22
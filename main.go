@@ -14,4 +14,253 @@ func main() {
 
 	fmt.Println("\nInterfaces-")
 	methods.DemoImplementationMethodsAndInterface()
+
+	fmt.Println("\nConcurrent areas-")
+	methods.DemoConcurrentAreas()
+
+	fmt.Println("\nGeneric list-")
+	methods.DemoGenericList()
+
+	fmt.Println("\nDispatch table-")
+	methods.DemoDispatchTable()
+
+	fmt.Println("\nDeep copy-")
+	methods.DemoDeepCopy()
+
+	fmt.Println("\nCancellable workers-")
+	methods.DemoCancellableWorkers()
+
+	fmt.Println("\nShallow struct copy-")
+	methods.DemoShallowStructCopy()
+
+	fmt.Println("\nRounded JSON-")
+	methods.DemoRoundedJSON()
+
+	fmt.Println("\nnew vs composite literal-")
+	methods.DemoNewVsLiteral()
+
+	fmt.Println("\nEasing-")
+	methods.DemoEasing()
+
+	fmt.Println("\nStruct tags-")
+	methods.DemoStructTags()
+
+	fmt.Println("\nMove log-")
+	methods.DemoMoveLog()
+
+	fmt.Println("\ncopy builtin-")
+	methods.DemoCopyBuiltin()
+
+	fmt.Println("\nGrid clustering-")
+	methods.DemoCluster()
+
+	fmt.Println("\nEmbedded interface-")
+	methods.DemoEmbeddedInterface()
+
+	fmt.Println("\nA* pathfinding-")
+	methods.DemoAStar()
+
+	fmt.Println("\nStringer vs error-")
+	methods.DemoStringerVsError()
+
+	fmt.Println("\nSnapshot diff-")
+	methods.DemoDiff()
+
+	fmt.Println("\nOrdered concurrent magnitudes-")
+	methods.DemoOrderedConcurrentMagnitudes()
+
+	fmt.Println("\nSpatial map-")
+	methods.DemoSpatialMap()
+
+	fmt.Println("\nCoordinate equality-")
+	methods.DemoCoordinateEquality()
+
+	fmt.Println("\nRange over int-")
+	methods.DemoRangeOverInt()
+
+	fmt.Println("\nBounding circle-")
+	methods.DemoBoundingCircle()
+
+	fmt.Println("\nNon-blocking send-")
+	methods.DemoTrySend()
+
+	fmt.Println("\nLRU cache-")
+	methods.DemoLRU()
+
+	fmt.Println("\nslices.EqualFunc-")
+	methods.DemoEqualFunc()
+
+	fmt.Println("\nDeep almost equal-")
+	methods.DemoDeepAlmostEqual()
+
+	fmt.Println("\nCustom panic-")
+	methods.DemoCustomPanic()
+
+	fmt.Println("\nDistance to segment-")
+	methods.DemoDistanceToSegment()
+
+	fmt.Println("\nPath simplification-")
+	methods.DemoSimplifyPath()
+
+	fmt.Println("\nTotal area-")
+	methods.DemoTotalArea()
+
+	fmt.Println("\nGame of Life-")
+	methods.DemoLife()
+
+	fmt.Println("\nHypot vs Sqrt-")
+	methods.DemoHypot()
+
+	fmt.Println("\nAtomic counter-")
+	methods.DemoAtomicCounter()
+
+	fmt.Println("\nRead-only view-")
+	methods.DemoReadOnlyVertex()
+
+	fmt.Println("\nStruct of arrays-")
+	methods.DemoVertexBatch()
+
+	fmt.Println("\nPromotion conflict-")
+	methods.DemoPromotionConflict()
+
+	fmt.Println("\nQuad-tree-")
+	methods.DemoQuadTree()
+
+	fmt.Println("\nDegrees and radians-")
+	methods.DemoAngles()
+
+	fmt.Println("\nResult type-")
+	methods.DemoResult()
+
+	fmt.Println("\nBezier curves-")
+	methods.DemoBezier()
+
+	fmt.Println("\nNumerical integration-")
+	methods.DemoIntegration()
+
+	fmt.Println("\nFormatting paths-")
+	methods.DemoFormatPath()
+
+	fmt.Println("\nClosure generator-")
+	methods.DemoClosureGenerator()
+
+	fmt.Println("\n2D convolution-")
+	methods.DemoConvolve()
+
+	fmt.Println("\nDefer and receivers-")
+	methods.DemoDeferReceiverCopy()
+
+	fmt.Println("\nLine fitting-")
+	methods.DemoFitLine()
+
+	fmt.Println("\nBuffered sink-")
+	methods.DemoBufferedSink()
+
+	fmt.Println("\nReturning copies-")
+	methods.DemoReturnCopy()
+
+	fmt.Println("\nk-means-")
+	methods.DemoKMeans()
+
+	fmt.Println("\nRWMutex store-")
+	methods.DemoVertexStore()
+
+	fmt.Println("\n3D-")
+	methods.DemoImplementationMethods3D()
+
+	fmt.Println("\nShape registry-")
+	methods.DemoShapeRegistry()
+
+	fmt.Println("\nGeneric vs interface sums-")
+	methods.DemoSumMagnitudes()
+
+	fmt.Println("\nStruct size and alignment-")
+	methods.DemoStructSize()
+
+	fmt.Println("\nVoronoi classification-")
+	methods.DemoVoronoi()
+
+	fmt.Println("\nMap iteration order-")
+	methods.DemoMapOrder()
+
+	fmt.Println("\nEmbedded nil pointer-")
+	methods.DemoEmbeddedNilPointer()
+
+	fmt.Println("\nNumerical gradient-")
+	methods.DemoGradient()
+
+	fmt.Println("\nGenerics-")
+	methods.DemoImplementationMethodsGenerics()
+
+	fmt.Println("\nJoined validation errors-")
+	methods.DemoValidateVertices()
+
+	fmt.Println("\nPipeline-")
+	methods.DemoPipeline()
+
+	fmt.Println("\nMethod values and expressions-")
+	methods.DemoMethodValuesAndExpressions()
+
+	fmt.Println("\nAffine transforms-")
+	methods.DemoAffine()
+
+	fmt.Println("\nEmbedding-")
+	methods.DemoEmbedding()
+
+	fmt.Println("\nInterface composition-")
+	methods.DemoInterfaceComposition()
+
+	fmt.Println("\nRun-time interface checks-")
+	methods.DemoMustImplement()
+
+	fmt.Println("\nType switch-")
+	methods.DemoTypeSwitch()
+
+	fmt.Println("\nValue noise-")
+	methods.DemoValueNoise()
+
+	fmt.Println("\nType assertion-")
+	methods.DemoTypeAssertion()
+
+	fmt.Println("\nJSON struct tags-")
+	methods.DemoJSONTags()
+
+	fmt.Println("\nGeometryError-")
+	methods.DemoGeometryError()
+
+	fmt.Println("\nJSON-")
+	methods.DemoJSON()
+
+	fmt.Println("\nRunSimulation-")
+	methods.DemoRunSimulation()
+
+	fmt.Println("\nRotate-")
+	methods.DemoRotate()
+
+	fmt.Println("\nSpring-")
+	methods.DemoSpring()
+
+	fmt.Println("\nDeepEqual with func fields-")
+	methods.DemoDeepEqualFuncFields()
+
+	fmt.Println("\nVertex angle-")
+	methods.DemoVertexAngle()
+
+	fmt.Println("\nRasterizeLine-")
+	methods.DemoRasterizeLine()
+
+	fmt.Println("\nPolar coordinates-")
+	methods.DemoPolar()
+
+	fmt.Println("\nTypedWrapper-")
+	methods.DemoTypedWrapper()
+
+	fmt.Println("\nWindows-")
+	methods.DemoWindows()
+
+	fmt.Println("\nBigVertex-")
+	methods.DemoBigVertex()
+
+	fmt.Println("\nCurvature-")
+	methods.DemoCurvature()
 }
@@ -0,0 +1,40 @@
+package methods
+
+import (
+	"fmt"
+	"math"
+)
+
+// The same methods generalize to three dimensions: only the number of components changes.
+// Vertex3D mirrors the value-receiver API of Vertex, so code written against one reads the same for the other.
+
+type Vertex3D struct {
+	X, Y, Z float64
+}
+
+func (v Vertex3D) Absolute() float64 {
+	return math.Sqrt(v.X*v.X + v.Y*v.Y + v.Z*v.Z)
+}
+
+func (v Vertex3D) Add(other Vertex3D) Vertex3D {
+	return Vertex3D{X: v.X + other.X, Y: v.Y + other.Y, Z: v.Z + other.Z}
+}
+
+func (v Vertex3D) Scale(f float64) Vertex3D {
+	return Vertex3D{X: v.X * f, Y: v.Y * f, Z: v.Z * f}
+}
+
+func (v Vertex3D) Dot(other Vertex3D) float64 {
+	return v.X*other.X + v.Y*other.Y + v.Z*other.Z
+}
+
+func DemoImplementationMethods3D() {
+	v := Vertex3D{X: 1, Y: 2, Z: 2}
+	w := Vertex3D{X: 3, Y: 0, Z: -1}
+
+	fmt.Println("Absolute (v):", v.Absolute())
+	fmt.Println("Add (v + w):", v.Add(w))
+	fmt.Println("Scale (v * 2):", v.Scale(2), v.Scale(2).Absolute())
+	fmt.Println("Dot (v . w):", v.Dot(w))
+	fmt.Println("Dot (v . v) and Absolute squared:", v.Dot(v), v.Absolute()*v.Absolute())
+}
@@ -0,0 +1,19 @@
+package methods
+
+import "testing"
+
+func TestVertex3DAbsolute(t *testing.T) {
+	tests := []struct {
+		v    Vertex3D
+		want float64
+	}{
+		{Vertex3D{X: 1, Y: 2, Z: 2}, 3},
+		{Vertex3D{}, 0},
+		{Vertex3D{X: -2, Y: -3, Z: -6}, 7},
+	}
+	for _, tt := range tests {
+		if got := tt.v.Absolute(); got != tt.want {
+			t.Errorf("%v.Absolute() = %v, want %v", tt.v, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package methods
+
+import (
+	"fmt"
+	"math"
+)
+
+// A 2x2 matrix can rotate, scale and shear a vertex, but it always maps the origin to itself, so it can't translate.
+// Homogeneous coordinates fix that by writing a vertex as (x, y, 1) and using a 3x3 matrix:
+//
+//	| A  B  TX |   | x |   | A*x + B*y + TX |
+//	| C  D  TY | * | y | = | C*x + D*y + TY |
+//	| 0  0  1  |   | 1 |   |       1        |
+//
+// The bottom row is always 0 0 1 for affine transforms, so Affine stores only the other six entries.
+
+type Affine struct {
+	A, B, TX float64
+	C, D, TY float64
+}
+
+func IdentityAffine() Affine {
+	return Affine{A: 1, D: 1}
+}
+
+func TranslateAffine(dx, dy float64) Affine {
+	return Affine{A: 1, D: 1, TX: dx, TY: dy}
+}
+
+// RotateAffine rotates counter-clockwise around the origin, like Vertex.Rotate.
+func RotateAffine(radians float64) Affine {
+	sin, cos := math.Sincos(radians)
+	return Affine{A: cos, B: -sin, C: sin, D: cos}
+}
+
+func ScaleAffine(sx, sy float64) Affine {
+	return Affine{A: sx, D: sy}
+}
+
+func (m Affine) Apply(v Vertex) Vertex {
+	return Vertex{
+		X: m.A*v.X + m.B*v.Y + m.TX,
+		Y: m.C*v.X + m.D*v.Y + m.TY,
+	}
+}
+
+// Compose returns the single transform equivalent to applying other first and then m
+// (the matrix product m * other). Reading a chain right to left gives the order of application.
+func (m Affine) Compose(other Affine) Affine {
+	return Affine{
+		A:  m.A*other.A + m.B*other.C,
+		B:  m.A*other.B + m.B*other.D,
+		TX: m.A*other.TX + m.B*other.TY + m.TX,
+		C:  m.C*other.A + m.D*other.C,
+		D:  m.C*other.B + m.D*other.D,
+		TY: m.C*other.TX + m.D*other.TY + m.TY,
+	}
+}
+
+func DemoAffine() {
+	// Scale by 2, then rotate a quarter turn, then move 10 to the right.
+	scale := ScaleAffine(2, 2)
+	rotate := RotateAffine(math.Pi / 2)
+	translate := TranslateAffine(10, 0)
+	combined := translate.Compose(rotate).Compose(scale)
+
+	v := Vertex{X: 1, Y: 0}
+	stepByStep := translate.Apply(rotate.Apply(scale.Apply(v)))
+	fmt.Println("Step by step:", stepByStep)
+	fmt.Println("Combined:", combined.Apply(v))
+	fmt.Println("Same result:", stepByStep.Equals(combined.Apply(v), 1e-9))
+
+	// Order matters: translating first moves the point before it's rotated about the origin.
+	other := scale.Compose(rotate).Compose(translate)
+	fmt.Printf("Reversed order: (%.3f, %.3f)\n", other.Apply(v).X, other.Apply(v).Y)
+	fmt.Println("Identity:", IdentityAffine().Apply(v))
+}
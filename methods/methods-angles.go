@@ -0,0 +1,85 @@
+package methods
+
+import (
+	"fmt"
+	"math"
+)
+
+// The math package, and so every angle-based method here, works in radians: a full turn is 2π.
+// These helpers convert at the edges for callers who think in degrees.
+
+func DegToRad(d float64) float64 {
+	return d * math.Pi / 180
+}
+
+func RadToDeg(r float64) float64 {
+	return r * 180 / math.Pi
+}
+
+// RotateDegrees is Rotate with the angle given in degrees.
+func (v Vertex) RotateDegrees(deg float64) Vertex {
+	return v.Rotate(DegToRad(deg))
+}
+
+// Angle returns the direction of v, measured counter-clockwise from the positive X axis, in radians.
+// math.Atan2 looks at the signs of both arguments, so the result covers the full circle, from -π to π.
+// The zero vector has no direction; Atan2(0, 0) returns 0.
+func (v Vertex) Angle() float64 {
+	return math.Atan2(v.Y, v.X)
+}
+
+// NewVertexFromPolar builds a Vertex from a length and a direction in radians. It's the inverse of
+// Absolute and Angle, up to rounding: a negative radius flips the direction, and angles wrap around every 2π.
+func NewVertexFromPolar(radius, radians float64) Vertex {
+	sin, cos := math.Sincos(radians)
+	return Vertex{X: radius * cos, Y: radius * sin}
+}
+
+func DemoAngles() {
+	fmt.Println("90 degrees in radians:", DegToRad(90))
+	fmt.Println("π radians in degrees:", RadToDeg(math.Pi))
+
+	r := Vertex{X: 1, Y: 0}.RotateDegrees(90)
+	// The result is Vertex(6.1e-17, 1) rather than exactly Vertex(0, 1), because π/2 can't be represented exactly.
+	fmt.Println("Vertex(1, 0) rotated by 90 degrees:", r)
+	fmt.Printf("Rounded: (%.3f, %.3f)\n", r.X, r.Y)
+}
+
+// Rotate returns a transformed copy and leaves v alone, like the other value-receiver methods on Vertex.
+// Its results almost never match the exact answer bit for bit, so compare them with Equals and a tolerance.
+func DemoRotate() {
+	const eps = 1e-9
+	v := Vertex{X: 1, Y: 0}
+	r := v.Rotate(math.Pi / 2)
+	fmt.Println("Rotate(π/2):", r)
+	fmt.Println("Original unchanged:", v)
+	fmt.Println("== Vertex(0, 1):", r == Vertex{X: 0, Y: 1})
+	fmt.Println("Equals(Vertex(0, 1), 1e-9):", r.Equals(Vertex{X: 0, Y: 1}, eps))
+
+	w := Vertex{X: 3, Y: 4}
+	fmt.Println("Rotate(π) of (3, 4) ≈ (-3, -4):", w.Rotate(math.Pi).Equals(Vertex{X: -3, Y: -4}, eps))
+	fmt.Println("Full turn returns to start:", w.Rotate(2*math.Pi).Equals(w, eps))
+	fmt.Println("Length preserved:", math.Abs(w.Rotate(1).Absolute()-w.Absolute()) < eps)
+}
+
+// Absolute and Angle together describe a vector in polar form: how long it is and which way it points.
+func DemoVertexAngle() {
+	fmt.Println("Angle of Vertex(0, 1) is π/2:", Vertex{X: 0, Y: 1}.Angle() == math.Pi/2)
+	for _, v := range []Vertex{{X: 1, Y: 1}, {X: -1, Y: 1}, {X: -1, Y: -1}, {X: 1, Y: -1}, {X: 0, Y: 0}} {
+		fmt.Printf("Angle of %v: %.2f rad (%.0f degrees)\n", v, v.Angle(), RadToDeg(v.Angle()))
+	}
+}
+
+func DemoPolar() {
+	const eps = 1e-9
+	u := NewVertexFromPolar(1, math.Pi/4)
+	fmt.Println("Unit vector at π/4:", u)
+	fmt.Println("Absolute ≈ 1:", math.Abs(u.Absolute()-1) < eps)
+
+	// Round trip: polar -> Vertex -> (Absolute, Angle) gives back the same radius and angle.
+	for _, p := range []struct{ radius, radians float64 }{{5, 0.3}, {2, 2}, {1, -1.5}, {3, -3}} {
+		v := NewVertexFromPolar(p.radius, p.radians)
+		ok := math.Abs(v.Absolute()-p.radius) < eps && math.Abs(v.Angle()-p.radians) < eps
+		fmt.Printf("Polar (%v, %v) -> %v, round trip ok: %v\n", p.radius, p.radians, v, ok)
+	}
+}
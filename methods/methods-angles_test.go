@@ -0,0 +1,48 @@
+package methods
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAngle(t *testing.T) {
+	const epsilon = 1e-12
+	tests := []struct {
+		name string
+		v    Vertex
+		want float64
+	}{
+		{"positive Y axis", Vertex{X: 0, Y: 1}, math.Pi / 2},
+		{"first quadrant", Vertex{X: 1, Y: 1}, math.Pi / 4},
+		{"second quadrant", Vertex{X: -1, Y: 1}, 3 * math.Pi / 4},
+		{"third quadrant", Vertex{X: -1, Y: -1}, -3 * math.Pi / 4},
+		{"fourth quadrant", Vertex{X: 1, Y: -1}, -math.Pi / 4},
+		{"zero vector", Vertex{}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.v.Angle(); math.Abs(got-tt.want) > epsilon {
+				t.Errorf("%v.Angle() = %v, want %v", tt.v, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewVertexFromPolarRoundTrip(t *testing.T) {
+	const epsilon = 1e-9
+	u := NewVertexFromPolar(1, math.Pi/4)
+	if math.Abs(u.Absolute()-1) > epsilon {
+		t.Errorf("NewVertexFromPolar(1, π/4) = %v with length %v, want 1", u, u.Absolute())
+	}
+
+	// Angle returns values between -π and π, so only angles in that range come back unchanged.
+	for _, p := range []struct{ radius, radians float64 }{{5, 0.3}, {2, 2}, {1, -1.5}, {3, -3}, {0.5, math.Pi}} {
+		v := NewVertexFromPolar(p.radius, p.radians)
+		if got := v.Absolute(); math.Abs(got-p.radius) > epsilon {
+			t.Errorf("NewVertexFromPolar(%v, %v).Absolute() = %v, want %v", p.radius, p.radians, got, p.radius)
+		}
+		if got := v.Angle(); math.Abs(got-p.radians) > epsilon {
+			t.Errorf("NewVertexFromPolar(%v, %v).Angle() = %v, want %v", p.radius, p.radians, got, p.radians)
+		}
+	}
+}
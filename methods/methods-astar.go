@@ -0,0 +1,114 @@
+package methods
+
+import (
+	"container/heap"
+	"fmt"
+)
+
+// A* finds a shortest path by always expanding the open node with the lowest f = g + h,
+// where g is the cost so far and h is a heuristic estimate of the remaining cost.
+// On a 4-connected grid with unit steps, ManhattanDistance never overestimates,
+// so the first time the goal is taken off the queue its path is optimal.
+//
+// The priority queue implements heap.Interface. Instead of updating priorities in place,
+// a node is pushed again whenever a cheaper route to it is found, and stale entries are skipped when popped.
+
+type astarItem struct {
+	v Vertex
+	f float64
+}
+
+type astarQueue []astarItem
+
+func (q astarQueue) Len() int            { return len(q) }
+func (q astarQueue) Less(i, j int) bool  { return q[i].f < q[j].f }
+func (q astarQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *astarQueue) Push(x interface{}) { *q = append(*q, x.(astarItem)) }
+func (q *astarQueue) Pop() interface{} {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
+
+var gridNeighbours = []Vertex{{X: 1, Y: 0}, {X: -1, Y: 0}, {X: 0, Y: 1}, {X: 0, Y: -1}}
+
+// AStar returns a shortest 4-connected path from start to goal, both included,
+// visiting only vertices for which walkable returns true. Coordinates are expected to be integers,
+// which also makes Vertex safe to use as a map key here.
+// walkable must return false outside some bounded area, otherwise an unreachable goal would be searched for forever.
+// ok is false when no path exists.
+func AStar(start, goal Vertex, walkable func(Vertex) bool) ([]Vertex, bool) {
+	if !walkable(start) || !walkable(goal) {
+		return nil, false
+	}
+
+	g := map[Vertex]float64{start: 0}
+	cameFrom := make(map[Vertex]Vertex)
+	closed := make(map[Vertex]bool)
+
+	open := &astarQueue{{v: start, f: start.ManhattanDistance(goal)}}
+	for open.Len() > 0 {
+		current := heap.Pop(open).(astarItem).v
+		if closed[current] {
+			continue
+		}
+		if current == goal {
+			return reconstructPath(cameFrom, current), true
+		}
+		closed[current] = true
+
+		for _, d := range gridNeighbours {
+			next := Vertex{X: current.X + d.X, Y: current.Y + d.Y}
+			if closed[next] || !walkable(next) {
+				continue
+			}
+			cost := g[current] + 1
+			if known, ok := g[next]; ok && cost >= known {
+				continue
+			}
+			g[next] = cost
+			cameFrom[next] = current
+			heap.Push(open, astarItem{v: next, f: cost + next.ManhattanDistance(goal)})
+		}
+	}
+	return nil, false
+}
+
+func reconstructPath(cameFrom map[Vertex]Vertex, end Vertex) []Vertex {
+	path := []Vertex{end}
+	for {
+		prev, ok := cameFrom[end]
+		if !ok {
+			break
+		}
+		path = append(path, prev)
+		end = prev
+	}
+	// The path was built goal-first, so reverse it.
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+func DemoAStar() {
+	// A 5x5 grid with a wall at x=2 that has a single gap at the top (y=4).
+	walkable := func(v Vertex) bool {
+		if v.X < 0 || v.Y < 0 || v.X > 4 || v.Y > 4 {
+			return false
+		}
+		return !(v.X == 2 && v.Y < 4)
+	}
+
+	path, ok := AStar(Vertex{X: 0, Y: 0}, Vertex{X: 4, Y: 0}, walkable)
+	fmt.Println("Path found:", ok, "steps:", len(path)-1)
+	fmt.Println("Path:", path)
+
+	// Close the gap and there is no way through.
+	blocked := func(v Vertex) bool {
+		return walkable(v) && v.X != 2
+	}
+	_, ok = AStar(Vertex{X: 0, Y: 0}, Vertex{X: 4, Y: 0}, blocked)
+	fmt.Println("Path found with the gap closed:", ok)
+}
@@ -0,0 +1,54 @@
+package methods
+
+import "fmt"
+
+// A []Vertex is an "array of structs": X and Y of each vertex sit next to each other in memory.
+// VertexBatch is the "struct of arrays" layout: all the Xs together, then all the Ys.
+// Loops that run the same operation over one field at a time read memory strictly sequentially in the second layout,
+// which is what CPU caches, prefetchers and SIMD units handle best.
+
+type VertexBatch struct {
+	Xs, Ys []float64
+}
+
+func NewVertexBatch(vs []Vertex) VertexBatch {
+	b := VertexBatch{Xs: make([]float64, len(vs)), Ys: make([]float64, len(vs))}
+	for i, v := range vs {
+		b.Xs[i] = v.X
+		b.Ys[i] = v.Y
+	}
+	return b
+}
+
+// Vertices converts the batch back to a []Vertex.
+func (b VertexBatch) Vertices() []Vertex {
+	vs := make([]Vertex, len(b.Xs))
+	for i := range vs {
+		vs[i] = Vertex{X: b.Xs[i], Y: b.Ys[i]}
+	}
+	return vs
+}
+
+func (b VertexBatch) Len() int {
+	return len(b.Xs)
+}
+
+// ScaleAll scales every vertex in place. The slices share their backing arrays with b,
+// so a value receiver is enough to modify the elements.
+func (b VertexBatch) ScaleAll(f float64) {
+	for i := range b.Xs {
+		b.Xs[i] *= f
+	}
+	for i := range b.Ys {
+		b.Ys[i] *= f
+	}
+}
+
+func DemoVertexBatch() {
+	vs := []Vertex{{X: 1, Y: 2}, {X: 3, Y: 4}, {X: 5, Y: 6}}
+	batch := NewVertexBatch(vs)
+	fmt.Println("Batch layout:", batch.Xs, batch.Ys)
+
+	batch.ScaleAll(10)
+	fmt.Println("Scaled back to vertices:", batch.Vertices())
+}
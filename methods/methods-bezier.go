@@ -0,0 +1,32 @@
+package methods
+
+import "fmt"
+
+// De Casteljau's algorithm evaluates a Bézier curve using nothing but repeated Lerp:
+// interpolate between each pair of neighbouring control points, then between those results,
+// until a single point remains. The curve passes through the first and last control points
+// and is pulled towards (but generally not through) the ones in between.
+
+func QuadraticBezier(p0, p1, p2 Vertex, t float64) Vertex {
+	a := p0.Lerp(p1, t)
+	b := p1.Lerp(p2, t)
+	return a.Lerp(b, t)
+}
+
+func CubicBezier(p0, p1, p2, p3 Vertex, t float64) Vertex {
+	a := QuadraticBezier(p0, p1, p2, t)
+	b := QuadraticBezier(p1, p2, p3, t)
+	return a.Lerp(b, t)
+}
+
+func DemoBezier() {
+	p0, p1, p2, p3 := Vertex{X: 0, Y: 0}, Vertex{X: 0, Y: 10}, Vertex{X: 10, Y: 10}, Vertex{X: 10, Y: 0}
+
+	for i := 0; i <= 4; i++ {
+		t := float64(i) / 4
+		fmt.Printf("t=%.2f quadratic=%v cubic=%v\n", t, QuadraticBezier(p0, p1, p2, t), CubicBezier(p0, p1, p2, p3, t))
+	}
+
+	fmt.Println("Cubic starts at p0:", CubicBezier(p0, p1, p2, p3, 0) == p0)
+	fmt.Println("Cubic ends at p3:", CubicBezier(p0, p1, p2, p3, 1) == p3)
+}
@@ -0,0 +1,68 @@
+package methods
+
+import "fmt"
+
+// Centroid returns the average of vs. ok is false for an empty slice, which has no centroid.
+func Centroid(vs []Vertex) (Vertex, bool) {
+	if len(vs) == 0 {
+		return Vertex{}, false
+	}
+	var sum Vertex
+	for _, v := range vs {
+		sum.X += v.X
+		sum.Y += v.Y
+	}
+	n := float64(len(vs))
+	return Vertex{X: sum.X / n, Y: sum.Y / n}, true
+}
+
+// BoundingCircle returns a circle containing every vertex in vs.
+// It is an approximation: the center is the centroid and the radius is the distance to the farthest point,
+// which always encloses all points but can be noticeably larger than the true minimum enclosing circle
+// when the points are unevenly distributed. ok is false for empty input.
+func BoundingCircle(vs []Vertex) (center Vertex, radius float64, ok bool) {
+	center, ok = Centroid(vs)
+	if !ok {
+		return Vertex{}, 0, false
+	}
+	for _, v := range vs {
+		if d := center.Distance(v); d > radius {
+			radius = d
+		}
+	}
+	return center, radius, true
+}
+
+func DemoBoundingCircle() {
+	cloud := []Vertex{{X: 1, Y: 1}, {X: 4, Y: 2}, {X: 3, Y: 5}, {X: -1, Y: 3}, {X: 2, Y: -2}}
+
+	center, radius, ok := BoundingCircle(cloud)
+	fmt.Printf("Bounding circle: center %v radius %.3f ok %v\n", center, radius, ok)
+
+	inside := true
+	for _, v := range cloud {
+		if center.Distance(v) > radius {
+			inside = false
+		}
+	}
+	fmt.Println("All points inside:", inside)
+
+	_, _, ok = BoundingCircle(nil)
+	fmt.Println("Empty input ok:", ok)
+}
+
+// AABB is an axis-aligned bounding box: the rectangle from Min to Max with sides parallel to the axes.
+type AABB struct {
+	Min, Max Vertex
+}
+
+// Contains reports whether v lies inside b, boundary included.
+func (b AABB) Contains(v Vertex) bool {
+	return v.X >= b.Min.X && v.X <= b.Max.X && v.Y >= b.Min.Y && v.Y <= b.Max.Y
+}
+
+// Intersects reports whether b and other overlap, touching edges included.
+func (b AABB) Intersects(other AABB) bool {
+	return b.Min.X <= other.Max.X && other.Min.X <= b.Max.X &&
+		b.Min.Y <= other.Max.Y && other.Min.Y <= b.Max.Y
+}
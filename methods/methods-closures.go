@@ -0,0 +1,34 @@
+package methods
+
+import (
+	"fmt"
+	"math"
+)
+
+// A closure is a function value that refers to variables declared outside its body.
+// The variables live on as long as the closure does, so each call can pick up where the last one left off:
+// the closure below is a generator whose state (angle and radius) is invisible to the caller.
+
+// VertexSpiral returns a generator of points along an Archimedean spiral.
+// Each call advances the angle by step radians and the radius by step units.
+func VertexSpiral(step float64) func() Vertex {
+	angle, radius := 0.0, 0.0
+	return func() Vertex {
+		v := Vertex{X: radius * math.Cos(angle), Y: radius * math.Sin(angle)}
+		angle += step
+		radius += step
+		return v
+	}
+}
+
+func DemoClosureGenerator() {
+	next := VertexSpiral(math.Pi / 4)
+	for i := 0; i < 6; i++ {
+		v := next()
+		fmt.Printf("Spiral point %d: (%.3f, %.3f)\n", i, v.X, v.Y)
+	}
+
+	// Every call to VertexSpiral creates fresh state, so two generators don't affect each other.
+	other := VertexSpiral(math.Pi / 4)
+	fmt.Println("A new generator starts over at:", other())
+}
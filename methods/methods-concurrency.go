@@ -0,0 +1,239 @@
+package methods
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// A goroutine is a lightweight thread managed by the Go runtime.
+// Fanning work out to one goroutine per item is easy; keeping the results in input order takes a little care.
+// Here each goroutine writes only to its own index of a preallocated slice,
+// so no two goroutines touch the same element and no mutex is needed.
+// A sync.WaitGroup lets us wait until every goroutine has finished before reading the slice.
+
+func ConcurrentAreas(shapes []Shape) []float64 {
+	areas := make([]float64, len(shapes))
+
+	var wg sync.WaitGroup
+	wg.Add(len(shapes))
+	for i, s := range shapes {
+		// Pass i and s as arguments so each goroutine gets its own copy of the loop variables.
+		go func(i int, s Shape) {
+			defer wg.Done()
+			areas[i] = s.Area()
+		}(i, s)
+	}
+	wg.Wait()
+
+	return areas
+}
+
+func DemoConcurrentAreas() {
+	shapes := []Shape{
+		Rectangle{Width: 3, Height: 4},
+		Circle{Radius: 1},
+		Rectangle{Width: 10, Height: 0.5},
+		Circle{Radius: 2},
+	}
+
+	concurrent := ConcurrentAreas(shapes)
+	fmt.Println("Concurrent areas:", concurrent)
+
+	// Compare against a plain sequential loop. Run with `go run -race .` to confirm there are no data races.
+	matches := true
+	for i, s := range shapes {
+		if s.Area() != concurrent[i] {
+			matches = false
+		}
+	}
+	fmt.Println("Matches sequential results:", matches)
+}
+
+// A worker that only reads from a channel can block forever if nobody sends or closes it.
+// Selecting on ctx.Done() alongside every channel operation gives each worker a way out:
+// once the context is cancelled, the workers return and the output channel is closed.
+
+const cancellableWorkerCount = 4
+
+func CancellableWorkers(ctx context.Context, jobs <-chan Vertex) <-chan float64 {
+	results := make(chan float64)
+
+	var wg sync.WaitGroup
+	wg.Add(cancellableWorkerCount)
+	for i := 0; i < cancellableWorkerCount; i++ {
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case v, ok := <-jobs:
+					if !ok {
+						return
+					}
+					// Sending can block too, so it needs its own select.
+					select {
+					case results <- v.Absolute():
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}()
+	}
+
+	// Close results only after every worker has returned.
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	return results
+}
+
+func DemoCancellableWorkers() {
+	before := runtime.NumGoroutine()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// The producer never stops on its own; only cancellation ends it.
+	jobs := make(chan Vertex)
+	producerDone := make(chan struct{})
+	go func() {
+		defer close(producerDone)
+		for i := 1; ; i++ {
+			select {
+			case jobs <- Vertex{X: float64(3 * i), Y: float64(4 * i)}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	results := CancellableWorkers(ctx, jobs)
+	received := 0
+	for range results {
+		received++
+		if received == 5 {
+			cancel()
+		}
+	}
+	<-producerDone
+
+	// The for-range loop above only ends once results is closed, i.e. once every worker has exited.
+	// The goroutine that closed it may still be unwinding, so give the scheduler a moment before counting.
+	fmt.Println("At least 5 results received:", received >= 5)
+	for i := 0; i < 100 && runtime.NumGoroutine() > before; i++ {
+		time.Sleep(time.Millisecond)
+	}
+	fmt.Println("Goroutines leaked:", runtime.NumGoroutine()-before)
+}
+
+// A second way to keep results in order: instead of sharing a result slice,
+// each goroutine sends its index along with its value, and a single receiver puts the pieces back together.
+// Only the receiver writes to the slice, so the workers share nothing but the channel.
+
+type indexedResult struct {
+	index int
+	value float64
+}
+
+func OrderedConcurrentMagnitudes(vs []Vertex) []float64 {
+	// Buffered so no worker ever blocks waiting for the receiver.
+	results := make(chan indexedResult, len(vs))
+	for i, v := range vs {
+		go func(i int, v Vertex) {
+			results <- indexedResult{index: i, value: v.Absolute()}
+		}(i, v)
+	}
+
+	magnitudes := make([]float64, len(vs))
+	for range vs {
+		r := <-results
+		magnitudes[r.index] = r.value
+	}
+	return magnitudes
+}
+
+func DemoOrderedConcurrentMagnitudes() {
+	vs := []Vertex{{X: 3, Y: 4}, {X: 6, Y: 8}, {X: 5, Y: 12}, {X: 8, Y: 15}, {X: 0, Y: 0}}
+
+	concurrent := OrderedConcurrentMagnitudes(vs)
+	fmt.Println("Concurrent magnitudes:", concurrent)
+
+	matches := true
+	for i, v := range vs {
+		if v.Absolute() != concurrent[i] {
+			matches = false
+		}
+	}
+	fmt.Println("Matches sequential results:", matches)
+}
+
+// A select with a default case never blocks: if no other case is ready right now, default runs instead.
+// Wrapping a send this way turns "wait until there's room" into "send if there's room, otherwise give up".
+
+// TrySend sends v on ch if it can do so without blocking, and reports whether it did.
+func TrySend(ch chan<- Vertex, v Vertex) bool {
+	select {
+	case ch <- v:
+		return true
+	default:
+		return false
+	}
+}
+
+func DemoTrySend() {
+	ch := make(chan Vertex, 1)
+
+	fmt.Println("Send to empty buffered channel:", TrySend(ch, Vertex{X: 1, Y: 2}))
+	fmt.Println("Send to full buffered channel:", TrySend(ch, Vertex{X: 3, Y: 4}))
+	fmt.Println("Received:", <-ch)
+	fmt.Println("Send after draining:", TrySend(ch, Vertex{X: 5, Y: 6}))
+
+	// An unbuffered channel with no receiver waiting is never ready either.
+	fmt.Println("Send to unbuffered channel:", TrySend(make(chan Vertex), Vertex{}))
+}
+
+// Incrementing a plain int from several goroutines is a data race: two goroutines can read the same old value
+// and both write back old+1. The types in sync/atomic perform the read-modify-write as one indivisible step.
+// atomic.Int64 must not be copied once in use, so CountingVertex is always used through a pointer.
+
+type CountingVertex struct {
+	Vertex
+	calls atomic.Int64
+}
+
+// Absolute shadows the promoted Vertex.Absolute, counting each call before delegating to it.
+func (c *CountingVertex) Absolute() float64 {
+	c.calls.Add(1)
+	return c.Vertex.Absolute()
+}
+
+func (c *CountingVertex) CallCount() int64 {
+	return c.calls.Load()
+}
+
+func DemoAtomicCounter() {
+	const goroutines, callsEach = 8, 1000
+	c := &CountingVertex{Vertex: Vertex{X: 3, Y: 4}}
+
+	var wg sync.WaitGroup
+	wg.Add(goroutines)
+	for range goroutines {
+		go func() {
+			defer wg.Done()
+			for range callsEach {
+				c.Absolute()
+			}
+		}()
+	}
+	wg.Wait()
+
+	// Run with `go run -race .` to confirm the counter is race-free.
+	fmt.Println("Calls counted:", c.CallCount(), "expected:", goroutines*callsEach)
+}
@@ -0,0 +1,71 @@
+package methods
+
+import "fmt"
+
+// A 2D convolution replaces every sample of a field with a weighted sum of its neighbours,
+// where the weights come from a small kernel centred on the sample (blur, sharpen and edge detection are all kernels).
+// Here a Vertex is used as the integer (column, row) index of a sample, so offsets are just vector additions.
+
+// sampleAt returns field[v.Y][v.X], or 0 outside the field (zero-padding).
+func sampleAt(field [][]float64, v Vertex) float64 {
+	x, y := int(v.X), int(v.Y)
+	if y < 0 || y >= len(field) || x < 0 || x >= len(field[y]) {
+		return 0
+	}
+	return field[y][x]
+}
+
+// Convolve returns the convolution of field with kernel.
+// The output has the same dimensions as field (len(field) rows, each as long as the matching input row):
+// the kernel is centred on every input sample, and samples that fall outside the field count as zero.
+// The kernel centre is at (len(kernel[0])/2, len(kernel)/2), so odd-sized kernels are centred exactly.
+// As in the mathematical definition, the kernel is flipped; for symmetric kernels such as blurs this makes no difference.
+func Convolve(field [][]float64, kernel [][]float64) [][]float64 {
+	out := make([][]float64, len(field))
+	if len(kernel) == 0 {
+		for y := range field {
+			out[y] = make([]float64, len(field[y]))
+		}
+		return out
+	}
+	centre := Vertex{X: float64(len(kernel[0]) / 2), Y: float64(len(kernel) / 2)}
+
+	for y := range field {
+		out[y] = make([]float64, len(field[y]))
+		for x := range field[y] {
+			at := Vertex{X: float64(x), Y: float64(y)}
+			sum := 0.0
+			for ky := range kernel {
+				for kx := range kernel[ky] {
+					offset := Vertex{X: float64(kx), Y: float64(ky)}.Subtract(centre)
+					sum += kernel[ky][kx] * sampleAt(field, at.Subtract(offset))
+				}
+			}
+			out[y][x] = sum
+		}
+	}
+	return out
+}
+
+func DemoConvolve() {
+	// A single bright sample in the middle of a 5x5 field.
+	field := make([][]float64, 5)
+	for y := range field {
+		field[y] = make([]float64, 5)
+	}
+	field[2][2] = 9
+
+	// A 3x3 box blur averages each sample with its eight neighbours.
+	blur := [][]float64{
+		{1.0 / 9, 1.0 / 9, 1.0 / 9},
+		{1.0 / 9, 1.0 / 9, 1.0 / 9},
+		{1.0 / 9, 1.0 / 9, 1.0 / 9},
+	}
+
+	for _, row := range Convolve(field, blur) {
+		for _, v := range row {
+			fmt.Printf("%4.1f", v)
+		}
+		fmt.Println()
+	}
+}
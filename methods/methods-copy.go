@@ -0,0 +1,112 @@
+package methods
+
+import "fmt"
+
+// Assigning a struct copies its fields, but a field that is a slice or a pointer
+// only copies the reference, not the data it refers to. That is a shallow copy.
+// A deep copy also duplicates everything reachable through those references.
+
+type Scene struct {
+	Points []*Coordinate
+}
+
+// DeepCopy returns a Scene that shares no memory with s:
+// both the slice and every Coordinate it points to are freshly allocated.
+func (s *Scene) DeepCopy() *Scene {
+	if s == nil {
+		return nil
+	}
+	points := make([]*Coordinate, len(s.Points))
+	for i, p := range s.Points {
+		if p != nil {
+			c := *p
+			points[i] = &c
+		}
+	}
+	return &Scene{Points: points}
+}
+
+func DemoDeepCopy() {
+	original := &Scene{Points: []*Coordinate{{X: 1, Y: 2}, {X: 3, Y: 4}}}
+
+	// A shallow copy duplicates the Scene struct, but both slices hold the same pointers.
+	shallow := *original
+	shallow.Points[0].Scale(10)
+	fmt.Println("After scaling the shallow copy, original:", *original.Points[0], *original.Points[1])
+
+	// The deep copy has its own Coordinates, so scaling them leaves the original untouched.
+	deep := original.DeepCopy()
+	for _, p := range deep.Points {
+		p.Scale(2)
+	}
+	fmt.Println("After scaling the deep copy, original:", *original.Points[0], *original.Points[1])
+	fmt.Println("Deep copy:", *deep.Points[0], *deep.Points[1])
+}
+
+// A Vertex holds only float64 fields, so copying it copies all of its data.
+// A Polyline holds a slice, and a slice is a small header pointing at a shared backing array.
+// Copying a Polyline by value copies the header, so both copies still see the same points.
+
+type Polyline struct {
+	Points []Vertex
+}
+
+// NewPolyline copies points into a fresh backing array,
+// so later changes to the caller's slice can't reach into the Polyline.
+func NewPolyline(points []Vertex) Polyline {
+	owned := make([]Vertex, len(points))
+	copy(owned, points)
+	return Polyline{Points: owned}
+}
+
+func DemoShallowStructCopy() {
+	// Vertex values are fully independent after assignment.
+	v1 := Vertex{X: 1, Y: 1}
+	v2 := v1
+	v2.X = 100
+	fmt.Println("Vertex copies:", v1, v2)
+
+	// Polyline copies share the slice's backing array.
+	p1 := Polyline{Points: []Vertex{{X: 0, Y: 0}, {X: 1, Y: 1}}}
+	p2 := p1
+	p2.Points[0].X = 100
+	fmt.Println("Polyline copies share points:", p1.Points, p2.Points)
+
+	// Copying on construction breaks the link with the caller's slice.
+	points := []Vertex{{X: 0, Y: 0}, {X: 1, Y: 1}}
+	p3 := NewPolyline(points)
+	points[0].X = 100
+	fmt.Println("Caller's points and NewPolyline points:", points, p3.Points)
+}
+
+// The built-in copy(dst, src) copies min(len(dst), len(src)) elements and returns that count.
+// It copies element by element, and since a Vertex holds no pointers, each copied element is fully independent.
+// copy also handles overlapping slices of the same array correctly, as if through a temporary buffer.
+
+func DemoCopyBuiltin() {
+	src := []Vertex{{X: 1, Y: 1}, {X: 2, Y: 2}, {X: 3, Y: 3}}
+
+	// A shorter destination only receives as many elements as fit.
+	short := make([]Vertex, 2)
+	n := copy(short, src)
+	fmt.Println("Copied into shorter dst:", n, short)
+
+	// A longer destination keeps its zero values past the copied elements.
+	long := make([]Vertex, 5)
+	n = copy(long, src)
+	fmt.Println("Copied into longer dst:", n, long)
+
+	// Mutating the copy leaves the source alone.
+	short[0].X = 100
+	fmt.Println("Source after mutating the copy:", src)
+
+	// Shift elements left by one within the same slice: src and dst overlap.
+	path := []Vertex{{X: 0, Y: 0}, {X: 1, Y: 1}, {X: 2, Y: 2}, {X: 3, Y: 3}}
+	n = copy(path, path[1:])
+	fmt.Println("Overlapping shift left:", n, path)
+
+	// And shift right by one, which would corrupt the data with a naive forward loop.
+	path = []Vertex{{X: 0, Y: 0}, {X: 1, Y: 1}, {X: 2, Y: 2}, {X: 3, Y: 3}}
+	n = copy(path[1:], path)
+	fmt.Println("Overlapping shift right:", n, path)
+}
@@ -0,0 +1,56 @@
+package methods
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Diff returns the indices at which before and after differ by more than epsilon in either component.
+// If the slices have different lengths, every index past the end of the shorter one
+// counts as changed, since a point was added or removed there.
+func Diff(before, after []Vertex, epsilon float64) []int {
+	n := len(before)
+	if len(after) > n {
+		n = len(after)
+	}
+
+	var changed []int
+	for i := 0; i < n; i++ {
+		if i >= len(before) || i >= len(after) || !before[i].Equals(after[i], epsilon) {
+			changed = append(changed, i)
+		}
+	}
+	return changed
+}
+
+func DemoDiff() {
+	before := []Vertex{{X: 1, Y: 1}, {X: 2, Y: 2}, {X: 3, Y: 3}, {X: 4, Y: 4}}
+
+	after := make([]Vertex, len(before))
+	copy(after, before)
+	after[1].ScaleWithPointer(2)
+	after[3].ScaleWithPointer(1.0000001) // below the tolerance
+
+	fmt.Println("Changed indices:", Diff(before, after, 1e-3))
+	fmt.Println("Changed indices with an extra point:", Diff(before, append(after, Vertex{X: 5, Y: 5}), 1e-3))
+}
+
+// slices.Equal compares elements with ==, which is exact for float64 fields:
+// 0.1+0.2 and 0.3 differ in the last bit, so two "equal" paths can compare unequal.
+// slices.EqualFunc takes the comparison as a function instead, here a closure capturing epsilon.
+
+func VerticesEqualFunc(a, b []Vertex, epsilon float64) bool {
+	return slices.EqualFunc(a, b, func(u, v Vertex) bool {
+		return u.Equals(v, epsilon)
+	})
+}
+
+func DemoEqualFunc() {
+	x, y := 0.1, 0.2
+	a := []Vertex{{X: x + y, Y: 1}, {X: 2, Y: 2}}
+	b := []Vertex{{X: 0.3, Y: 1}, {X: 2, Y: 2}}
+
+	fmt.Println("slices.Equal:", slices.Equal(a, b))
+	fmt.Println("VerticesEqualFunc:", VerticesEqualFunc(a, b, 1e-9))
+	fmt.Println("VerticesEqualFunc with different lengths:", VerticesEqualFunc(a, b[:1], 1e-9))
+}
@@ -0,0 +1,74 @@
+package methods
+
+import (
+	"fmt"
+	"sort"
+)
+
+// A method expression such as Vertex.Dot turns a method into an ordinary function
+// whose first parameter is the receiver: Vertex.Dot has type func(Vertex, Vertex) float64.
+// Because every such expression is just a function value, several methods with the same shape
+// can be stored in a map and looked up by name at run time.
+
+var vertexOperations = map[string]func(Vertex, Vertex) float64{
+	"dot":      Vertex.Dot,
+	"distance": Vertex.Distance,
+	"cross":    Vertex.Cross,
+}
+
+// Invoke looks up op in the dispatch table and applies it to a and b.
+func Invoke(op string, a, b Vertex) (float64, error) {
+	f, ok := vertexOperations[op]
+	if !ok {
+		return 0, fmt.Errorf("unknown operation %q", op)
+	}
+	return f(a, b), nil
+}
+
+func DemoDispatchTable() {
+	a := Vertex{X: 1, Y: 2}
+	b := Vertex{X: 4, Y: 6}
+
+	// Sort the names so the output order doesn't depend on map iteration order.
+	names := make([]string, 0, len(vertexOperations))
+	for name := range vertexOperations {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		result, _ := Invoke(name, a, b)
+		fmt.Printf("Invoke(%q, %v, %v): %v\n", name, a, b, result)
+	}
+
+	if _, err := Invoke("angle", a, b); err != nil {
+		fmt.Println("Invoke error:", err)
+	}
+}
+
+// A method value, v.Absolute, binds the method to one particular receiver.
+// The receiver is evaluated and (for a value receiver) copied when the method value is created,
+// so the result is a func() float64 that keeps using that copy even if v changes later.
+//
+// A method expression, Vertex.Absolute, binds nothing: it is the method as a plain function
+// that takes the receiver as its first argument, func(Vertex) float64.
+// For pointer receivers the expression names the pointer type: (*Vertex).ScaleWithPointer is func(*Vertex, float64).
+
+func DemoMethodValuesAndExpressions() {
+	v := Vertex{X: 3, Y: 4}
+
+	methodValue := v.Absolute           // func() float64, bound to a copy of v
+	methodExpression := Vertex.Absolute // func(Vertex) float64
+
+	fmt.Println("Method value:", methodValue())
+	fmt.Println("Method expression:", methodExpression(v))
+	fmt.Println("Identical results:", methodValue() == methodExpression(v))
+
+	// Changing v afterwards doesn't affect the method value's bound copy.
+	v.ScaleWithPointer(2)
+	fmt.Println("After scaling v, method value / expression:", methodValue(), methodExpression(v))
+
+	scale := (*Vertex).ScaleWithPointer
+	scale(&v, 0.5)
+	fmt.Println("Pointer method expression (*Vertex).ScaleWithPointer:", v)
+}
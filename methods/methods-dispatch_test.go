@@ -0,0 +1,25 @@
+package methods
+
+import "testing"
+
+func TestMethodValueMatchesMethodExpression(t *testing.T) {
+	for _, v := range []Vertex{{}, {X: 3, Y: 4}, {X: -1.5, Y: 2}} {
+		methodValue := v.Absolute
+		methodExpression := Vertex.Absolute
+		if got, want := methodValue(), methodExpression(v); got != want {
+			t.Errorf("for %v, method value gave %v but method expression gave %v", v, got, want)
+		}
+	}
+}
+
+func TestMethodValueBindsACopy(t *testing.T) {
+	v := Vertex{X: 3, Y: 4}
+	methodValue := v.Absolute
+	v.ScaleWithPointer(2)
+	if got := methodValue(); got != 5 {
+		t.Errorf("method value after scaling v = %v, want 5 from the bound copy", got)
+	}
+	if got := Vertex.Absolute(v); got != 10 {
+		t.Errorf("method expression after scaling v = %v, want 10", got)
+	}
+}
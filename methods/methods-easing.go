@@ -0,0 +1,40 @@
+package methods
+
+import "fmt"
+
+// Easing functions reshape t before it reaches Lerp,
+// so a point can accelerate or decelerate along the same straight line.
+// Both helpers clamp t to [0, 1] and return from at t=0 and to at t=1.
+
+func clampUnit(t float64) float64 {
+	if t < 0 {
+		return 0
+	}
+	if t > 1 {
+		return 1
+	}
+	return t
+}
+
+// EaseInVertex starts slowly and speeds up (quadratic ease-in: t²).
+func EaseInVertex(from, to Vertex, t float64) Vertex {
+	t = clampUnit(t)
+	return from.Lerp(to, t*t)
+}
+
+// EaseOutVertex starts quickly and slows down (quadratic ease-out: 1-(1-t)²).
+func EaseOutVertex(from, to Vertex, t float64) Vertex {
+	t = clampUnit(t)
+	return from.Lerp(to, t*(2-t))
+}
+
+func DemoEasing() {
+	from := Vertex{X: 0, Y: 0}
+	to := Vertex{X: 100, Y: 50}
+
+	for i := 0; i <= 4; i++ {
+		t := float64(i) / 4
+		fmt.Printf("t=%.2f linear=%v ease-in=%v ease-out=%v\n",
+			t, from.Lerp(to, t), EaseInVertex(from, to, t), EaseOutVertex(from, to, t))
+	}
+}
@@ -0,0 +1,28 @@
+package methods
+
+import "fmt"
+
+// Go has no inheritance, but a struct can embed another type by listing it without a field name.
+// The embedded type's fields and methods are promoted: nv.X and nv.Absolute() work
+// as if NamedVertex had declared them, and the compiler rewrites them to nv.Vertex.X and nv.Vertex.Absolute().
+// The receiver is still the embedded Vertex; Absolute knows nothing about Name.
+
+type NamedVertex struct {
+	Name string
+	Vertex
+}
+
+func DemoEmbedding() {
+	nv := NamedVertex{Name: "corner", Vertex: Vertex{X: 3, Y: 4}}
+
+	fmt.Println("Promoted field X:", nv.X)
+	fmt.Println("Promoted method Absolute:", nv.Absolute())
+	fmt.Println("Explicit nv.Vertex.Absolute:", nv.Vertex.Absolute())
+
+	// Pointer-receiver methods are promoted too, as nv is addressable.
+	nv.ScaleWithPointer(2)
+	fmt.Println("After promoted ScaleWithPointer:", nv.Name, nv.Vertex)
+
+	// Promoted methods include String, so printing nv uses Vertex's String and the Name is lost.
+	fmt.Println("Printing a NamedVertex:", nv)
+}
@@ -0,0 +1,13 @@
+package methods
+
+import "testing"
+
+func TestNamedVertexPromotedAbsolute(t *testing.T) {
+	nv := NamedVertex{Name: "corner", Vertex: Vertex{X: 3, Y: 4}}
+	if got := nv.Absolute(); got != 5 {
+		t.Errorf("promoted Absolute() = %v, want 5", got)
+	}
+	if got, want := nv.Absolute(), nv.Vertex.Absolute(); got != want {
+		t.Errorf("nv.Absolute() = %v but nv.Vertex.Absolute() = %v", got, want)
+	}
+}
@@ -0,0 +1,75 @@
+package methods
+
+import "fmt"
+
+// Go controls visibility per identifier: names starting with a lower-case letter are unexported
+// and can't be used outside the package. That is the tool for building a read-only view.
+//
+// Embedding is the wrong tool here, even with an unexported embedded type: embedding promotes every method
+// of the embedded type, so a *ReadOnlyVertex would gain ScaleWithPointer and callers could mutate through it.
+// Instead, ReadOnlyVertex keeps the Vertex in an unexported field and forwards only the read methods.
+
+type ReadOnlyVertex struct {
+	v Vertex
+}
+
+func NewReadOnlyVertex(v Vertex) ReadOnlyVertex {
+	return ReadOnlyVertex{v: v}
+}
+
+func (r ReadOnlyVertex) X() float64 {
+	return r.v.X
+}
+
+func (r ReadOnlyVertex) Y() float64 {
+	return r.v.Y
+}
+
+func (r ReadOnlyVertex) Absolute() float64 {
+	return r.v.Absolute()
+}
+
+func DemoReadOnlyVertex() {
+	r := NewReadOnlyVertex(Vertex{X: 3, Y: 4})
+	fmt.Println("Read-only X, Y, Absolute:", r.X(), r.Y(), r.Absolute())
+
+	// None of these compile outside this package:
+	// r.v.X = 10             -> r.v undefined (cannot refer to unexported field v)
+	// r.ScaleWithPointer(2)  -> r.ScaleWithPointer undefined
+	// r.X = 10               -> X is a method, not a field
+}
+
+// Returning a struct by value hands the caller a copy; whatever they do to it stays on their side.
+// Returning a pointer to an internal field hands them the field itself, and any change they make
+// bypasses the owner's methods (and any checks those methods enforce).
+
+type Robot struct {
+	position Vertex
+}
+
+// Position returns a copy of the robot's position.
+func (r *Robot) Position() Vertex {
+	return r.position
+}
+
+// PositionPtr leaks a pointer to the internal position: callers can move the robot without calling Move.
+func (r *Robot) PositionPtr() *Vertex {
+	return &r.position
+}
+
+func (r *Robot) Move(dx, dy float64) {
+	r.position = r.position.Add(Vertex{X: dx, Y: dy})
+}
+
+func DemoReturnCopy() {
+	r := &Robot{}
+	r.Move(1, 2)
+
+	p := r.Position()
+	p.X = 100
+	fmt.Println("After editing the returned copy:", r.Position())
+
+	leaked := r.PositionPtr()
+	leaked.X = 100
+	fmt.Println("After editing through the leaked pointer:", r.Position())
+}
@@ -0,0 +1,113 @@
+package methods
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// error is the most widely used interface in Go: any type with an Error() string method satisfies it.
+// GeometryError records which operation failed and why.
+
+type GeometryError struct {
+	Op  string
+	Msg string
+}
+
+func (e GeometryError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Op, e.Msg)
+}
+
+var _ error = GeometryError{}
+
+// GeometryError is a comparable struct, so a package-level value of it works as a sentinel error:
+// errors.Is compares with ==, even through layers of wrapping added with fmt.Errorf("...: %w", err).
+var ErrZeroVector = GeometryError{Op: "normalize", Msg: "zero-length vector"}
+
+func DemoGeometryError() {
+	_, err := Vertex{}.Normalize()
+	fmt.Println("Error:", err)
+	fmt.Println("errors.Is(err, ErrZeroVector):", errors.Is(err, ErrZeroVector))
+
+	wrapped := fmt.Errorf("computing heading: %w", err)
+	fmt.Println("Wrapped:", wrapped)
+	fmt.Println("errors.Is(wrapped, ErrZeroVector):", errors.Is(wrapped, ErrZeroVector))
+
+	var ge GeometryError
+	if errors.As(wrapped, &ge) {
+		fmt.Printf("errors.As recovered Op=%q\n", ge.Op)
+	}
+}
+
+// panic accepts any value, not just strings or errors, and recover hands that same value back as an interface{}.
+// A type switch (or type assertion) on the recovered value tells us what kind of panic we caught.
+
+func recoverGeometryPanic(f func()) {
+	defer func() {
+		switch r := recover().(type) {
+		case nil:
+			fmt.Println("No panic")
+		case GeometryError:
+			fmt.Printf("Recovered GeometryError: Op=%q Msg=%q\n", r.Op, r.Msg)
+		default:
+			fmt.Printf("Recovered unexpected panic value %v (%T)\n", r, r)
+		}
+	}()
+	f()
+}
+
+func DemoCustomPanic() {
+	recoverGeometryPanic(func() {
+		panic(ErrZeroVector)
+	})
+
+	recoverGeometryPanic(func() {
+		var points []Vertex
+		fmt.Println(points[3]) // index out of range: the runtime panics with a runtime.Error
+	})
+
+	recoverGeometryPanic(func() {})
+}
+
+// errors.Join (Go 1.20) combines several errors into one. The joined error prints each message on its own line,
+// errors.Is and errors.As search every member, and the members themselves are available
+// through an Unwrap() []error method. That suits validation, where reporting every problem at once
+// beats stopping at the first.
+
+// ValidateVertices checks every vertex for NaN or infinite components and returns all failures joined,
+// or nil if every vertex is finite.
+func ValidateVertices(vs []Vertex) error {
+	var errs []error
+	for i, v := range vs {
+		for _, c := range []struct {
+			name  string
+			value float64
+		}{{"X", v.X}, {"Y", v.Y}} {
+			if math.IsNaN(c.value) || math.IsInf(c.value, 0) {
+				errs = append(errs, GeometryError{
+					Op:  fmt.Sprintf("validate vertex %d", i),
+					Msg: fmt.Sprintf("%s is %v", c.name, c.value),
+				})
+			}
+		}
+	}
+	return errors.Join(errs...) // nil when errs is empty
+}
+
+func DemoValidateVertices() {
+	vs := []Vertex{{X: 1, Y: 2}, {X: math.NaN(), Y: 0}, {X: 3, Y: 4}, {X: 0, Y: math.Inf(1)}}
+
+	err := ValidateVertices(vs)
+	fmt.Printf("Joined error:\n%v\n", err)
+
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		for _, e := range joined.Unwrap() {
+			var ge GeometryError
+			if errors.As(e, &ge) {
+				fmt.Printf("Member: Op=%q Msg=%q\n", ge.Op, ge.Msg)
+			}
+		}
+	}
+
+	fmt.Println("Valid input error:", ValidateVertices(vs[:1]))
+}
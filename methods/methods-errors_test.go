@@ -0,0 +1,33 @@
+package methods
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestGeometryErrorFormat(t *testing.T) {
+	err := GeometryError{Op: "normalize", Msg: "zero-length vector"}
+	if got, want := err.Error(), "normalize: zero-length vector"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+}
+
+func TestGeometryErrorIsAnError(t *testing.T) {
+	var err error = ErrZeroVector
+	if got, want := err.Error(), "normalize: zero-length vector"; got != want {
+		t.Errorf("Error() through the error interface = %q, want %q", got, want)
+	}
+
+	wrapped := fmt.Errorf("unit direction: %w", ErrZeroVector)
+	if !errors.Is(wrapped, ErrZeroVector) {
+		t.Errorf("errors.Is(%v, ErrZeroVector) = false, want true", wrapped)
+	}
+	var ge GeometryError
+	if !errors.As(wrapped, &ge) || ge.Op != "normalize" {
+		t.Errorf("errors.As(%v, &GeometryError) gave %+v, want Op \"normalize\"", wrapped, ge)
+	}
+	if errors.Is(wrapped, GeometryError{Op: "normalize", Msg: "other"}) {
+		t.Error("errors.Is matched a GeometryError with a different Msg")
+	}
+}
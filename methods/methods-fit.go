@@ -0,0 +1,48 @@
+package methods
+
+import "fmt"
+
+// FitLine finds the line y = slope*x + intercept that minimizes the sum of squared vertical distances
+// to points (ordinary least squares). Working with coordinates relative to the centroid keeps the sums small
+// and avoids the cancellation error of the textbook formula on large coordinates.
+//
+// ok is false for fewer than 2 points, and for vertical or near-vertical data, where the spread in X
+// is negligible next to the spread in Y: such a line has no finite slope, and any fitted slope would be noise.
+func FitLine(points []Vertex) (slope, intercept float64, ok bool) {
+	if len(points) < 2 {
+		return 0, 0, false
+	}
+	c, _ := Centroid(points)
+
+	var sxx, sxy, syy float64
+	for _, p := range points {
+		d := p.Subtract(c)
+		sxx += d.X * d.X
+		sxy += d.X * d.Y
+		syy += d.Y * d.Y
+	}
+	if sxx == 0 || sxx < 1e-12*syy {
+		return 0, 0, false
+	}
+
+	slope = sxy / sxx
+	return slope, c.Y - slope*c.X, true
+}
+
+func DemoFitLine() {
+	// Points near y = 2x + 1, with a fixed pattern of small errors.
+	noise := []float64{0.1, -0.2, 0.05, 0.15, -0.1, -0.05, 0.2, -0.15}
+	points := make([]Vertex, len(noise))
+	for i, n := range noise {
+		x := float64(i)
+		points[i] = Vertex{X: x, Y: 2*x + 1 + n}
+	}
+
+	slope, intercept, ok := FitLine(points)
+	fmt.Printf("Fit: y = %.3fx + %.3f (ok %v)\n", slope, intercept, ok)
+
+	_, _, ok = FitLine([]Vertex{{X: 1, Y: 0}, {X: 1, Y: 5}, {X: 1, Y: 9}})
+	fmt.Println("Vertical points ok:", ok)
+	_, _, ok = FitLine([]Vertex{{X: 1, Y: 1}})
+	fmt.Println("Single point ok:", ok)
+}
@@ -0,0 +1,102 @@
+package methods
+
+import (
+	"cmp"
+	"fmt"
+)
+
+// MyFloat and MyCustomFloat each needed their own Abs method.
+// With generics we can write Abs once, for every numeric type, as a function with a type parameter.
+// A constraint is an interface listing the types a type parameter may be;
+// the ~ means "any type whose underlying type is", so MyFloat (underlying float64) qualifies too.
+
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// AbsGeneric returns the absolute value of x. For unsigned types x is never negative and is returned unchanged.
+// Note that for signed integers the most negative value has no positive counterpart and is returned as is.
+func AbsGeneric[T Number](x T) T {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// cmp.Ordered (the standard-library successor to golang.org/x/exp/constraints.Ordered)
+// allows every type that supports < and >: integers, floats and strings.
+// Since Go 1.21 the built-in min and max do the same job; these show how such a function is written.
+
+func MinOf[T cmp.Ordered](a, b T) T {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func MaxOf[T cmp.Ordered](a, b T) T {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func DemoImplementationMethodsGenerics() {
+	fmt.Println("AbsGeneric[int]:", AbsGeneric(-7))
+	fmt.Println("AbsGeneric[int64]:", AbsGeneric(int64(-1<<40)))
+	fmt.Println("AbsGeneric[float32]:", AbsGeneric(float32(-2.5)))
+	fmt.Println("AbsGeneric[float64]:", AbsGeneric(-3.25))
+
+	// Works for named types too, matching the Abs methods on MyFloat and MyCustomFloat.
+	f := MyFloat(-1.5)
+	fmt.Println("AbsGeneric[MyFloat] vs MyFloat.Abs:", AbsGeneric(f), f.Abs())
+
+	fmt.Println("MinOf/MaxOf (-3, 2):", MinOf(-3, 2), MaxOf(-3, 2))
+	fmt.Println("MinOf/MaxOf (5, 5):", MinOf(5, 5), MaxOf(5, 5))
+	// Strings compare byte by byte, so upper-case letters sort before lower-case ones.
+	fmt.Println("MinOf/MaxOf (\"apple\", \"Banana\"):", MinOf("apple", "Banana"), MaxOf("apple", "Banana"))
+
+	// Method syntax isn't available: AbsGeneric is a function, and Go methods can't have type parameters.
+}
+
+// A type parameter can also be constrained by an ordinary method-set interface such as Absoluteness.
+// TypedWrapper holds a T directly. Unlike Wrapper, which embeds an Absoluteness interface value,
+// it knows the concrete type at compile time: Value() gives back a MyFloat or a *Coordinate
+// with no type assertion, and any T without an Abs method is rejected by the compiler.
+// (It is named TypedWrapper because Wrapper, the interface-embedding version, already exists.)
+//
+// Methods on a generic type repeat the type parameter in the receiver, (w TypedWrapper[T]),
+// but can't declare new type parameters of their own.
+
+type TypedWrapper[T Absoluteness] struct {
+	value T
+}
+
+func NewTypedWrapper[T Absoluteness](value T) TypedWrapper[T] {
+	return TypedWrapper[T]{value: value}
+}
+
+func (w TypedWrapper[T]) Magnitude() float64 {
+	return w.value.Abs()
+}
+
+func (w TypedWrapper[T]) Value() T {
+	return w.value
+}
+
+func DemoTypedWrapper() {
+	// T is inferred from the argument, so NewTypedWrapper(MyFloat(-2.5)) is a TypedWrapper[MyFloat].
+	f := NewTypedWrapper(MyFloat(-2.5))
+	fmt.Println("TypedWrapper[MyFloat] Magnitude:", f.Magnitude())
+
+	c := NewTypedWrapper(&Coordinate{X: 6, Y: 8})
+	fmt.Println("TypedWrapper[*Coordinate] Magnitude:", c.Magnitude())
+
+	// Value returns a *Coordinate, so its fields and pointer methods are available directly.
+	c.Value().Scale(0.5)
+	fmt.Println("After scaling the wrapped *Coordinate by 0.5:", c.Magnitude())
+
+	// NewTypedWrapper(Vertex{X: 3, Y: 4}) would not compile: Vertex has Absolute, not Abs.
+}
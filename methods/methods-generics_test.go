@@ -0,0 +1,46 @@
+package methods
+
+import "testing"
+
+func TestAbsGeneric(t *testing.T) {
+	if got := AbsGeneric(-7); got != 7 {
+		t.Errorf("AbsGeneric[int](-7) = %v, want 7", got)
+	}
+	if got := AbsGeneric(7); got != 7 {
+		t.Errorf("AbsGeneric[int](7) = %v, want 7", got)
+	}
+	if got := AbsGeneric(int64(-1 << 40)); got != 1<<40 {
+		t.Errorf("AbsGeneric[int64](-1<<40) = %v, want %v", got, int64(1<<40))
+	}
+	if got := AbsGeneric(float32(-2.5)); got != 2.5 {
+		t.Errorf("AbsGeneric[float32](-2.5) = %v, want 2.5", got)
+	}
+	if got := AbsGeneric(-3.25); got != 3.25 {
+		t.Errorf("AbsGeneric[float64](-3.25) = %v, want 3.25", got)
+	}
+	if got := AbsGeneric(0.0); got != 0 {
+		t.Errorf("AbsGeneric[float64](0) = %v, want 0", got)
+	}
+	if got := AbsGeneric(MyFloat(-1.5)); got != 1.5 {
+		t.Errorf("AbsGeneric[MyFloat](-1.5) = %v, want 1.5", got)
+	}
+}
+
+func TestMinOfMaxOf(t *testing.T) {
+	if lo, hi := MinOf(5, 5), MaxOf(5, 5); lo != 5 || hi != 5 {
+		t.Errorf("MinOf/MaxOf(5, 5) = %v, %v, want 5, 5", lo, hi)
+	}
+	if lo, hi := MinOf(-3, 2), MaxOf(-3, 2); lo != -3 || hi != 2 {
+		t.Errorf("MinOf/MaxOf(-3, 2) = %v, %v, want -3, 2", lo, hi)
+	}
+	if lo, hi := MinOf(-1.5, -2.5), MaxOf(-1.5, -2.5); lo != -2.5 || hi != -1.5 {
+		t.Errorf("MinOf/MaxOf(-1.5, -2.5) = %v, %v, want -2.5, -1.5", lo, hi)
+	}
+	// Strings compare byte by byte, so upper-case letters sort before lower-case ones.
+	if lo, hi := MinOf("apple", "Banana"), MaxOf("apple", "Banana"); lo != "Banana" || hi != "apple" {
+		t.Errorf("MinOf/MaxOf(\"apple\", \"Banana\") = %q, %q, want \"Banana\", \"apple\"", lo, hi)
+	}
+	if lo, hi := MinOf("apple", "banana"), MaxOf("apple", "banana"); lo != "apple" || hi != "banana" {
+		t.Errorf("MinOf/MaxOf(\"apple\", \"banana\") = %q, %q, want \"apple\", \"banana\"", lo, hi)
+	}
+}
@@ -0,0 +1,32 @@
+package methods
+
+import "fmt"
+
+// The gradient of a scalar field f points in the direction f increases fastest.
+// Without a formula for the derivatives we can estimate them numerically with central differences:
+// df/dx ≈ (f(x+h, y) - f(x-h, y)) / 2h, and likewise for y.
+//
+// Choosing h is a trade-off. The truncation error of a central difference shrinks like h²,
+// but a tiny h subtracts two nearly equal values and loses precision to rounding.
+// For float64 and coordinates of order 1, h around 1e-5 (roughly the cube root of machine epsilon) balances the two;
+// scale it with the magnitude of the coordinates.
+
+func Gradient(f func(Vertex) float64, at Vertex, h float64) Vertex {
+	dx := Vertex{X: h, Y: 0}
+	dy := Vertex{X: 0, Y: h}
+	return Vertex{
+		X: (f(at.Add(dx)) - f(at.Subtract(dx))) / (2 * h),
+		Y: (f(at.Add(dy)) - f(at.Subtract(dy))) / (2 * h),
+	}
+}
+
+func DemoGradient() {
+	// The gradient of the distance from the origin is the unit vector pointing away from it.
+	at := Vertex{X: 3, Y: 4}
+	g := Gradient(Vertex.Absolute, at, 1e-5)
+	unit, _ := at.Normalize()
+
+	fmt.Printf("Numerical gradient at %v: (%.6f, %.6f)\n", at, g.X, g.Y)
+	fmt.Println("Normalized position:", unit)
+	fmt.Println("Same direction:", g.Equals(unit, 1e-6))
+}
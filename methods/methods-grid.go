@@ -0,0 +1,105 @@
+package methods
+
+import (
+	"fmt"
+	"math"
+)
+
+// Dividing the plane into square cells of a fixed size gives every point an integer cell address.
+// math.Floor (rather than a plain int conversion, which truncates toward zero) keeps the cells the same size
+// on both sides of the axes: -0.5 falls in cell -1, not cell 0.
+
+type Cell struct {
+	X, Y int
+}
+
+// CellOf returns the grid cell containing v for cells of the given size.
+func (v Vertex) CellOf(size float64) Cell {
+	return Cell{
+		X: int(math.Floor(v.X / size)),
+		Y: int(math.Floor(v.Y / size)),
+	}
+}
+
+// Cluster groups vertices that share a grid cell. Clusters are returned in the order
+// their first vertex appears in vs, so the output is deterministic. A non-positive cellSize returns nil.
+func Cluster(vs []Vertex, cellSize float64) [][]Vertex {
+	if cellSize <= 0 {
+		return nil
+	}
+
+	index := make(map[Cell]int)
+	var clusters [][]Vertex
+	for _, v := range vs {
+		cell := v.CellOf(cellSize)
+		i, ok := index[cell]
+		if !ok {
+			i = len(clusters)
+			index[cell] = i
+			clusters = append(clusters, nil)
+		}
+		clusters[i] = append(clusters[i], v)
+	}
+	return clusters
+}
+
+func DemoCluster() {
+	cloud := []Vertex{
+		{X: 0.5, Y: 0.5}, {X: 1.5, Y: 1.2},
+		{X: 12, Y: 11}, {X: 13.5, Y: 14},
+		{X: -0.5, Y: -0.2}, {X: -3, Y: -1},
+		{X: 1.1, Y: 0.9},
+	}
+
+	fmt.Println("Cell of Vertex(-0.5, -0.2) with size 5:", Vertex{X: -0.5, Y: -0.2}.CellOf(5))
+	for i, c := range Cluster(cloud, 5) {
+		fmt.Printf("Cluster %d: %v\n", i, c)
+	}
+}
+
+// Hash quantizes v to its grid cell and returns the cell as a string key,
+// so every point in the same cell hashes to the same value.
+func (v Vertex) Hash(cellSize float64) string {
+	c := v.CellOf(cellSize)
+	return fmt.Sprintf("%d:%d", c.X, c.Y)
+}
+
+// SpatialMap is a spatial hash: vertices are bucketed by the cell they fall in,
+// so finding points near p only means looking at p's bucket instead of scanning every point.
+//
+// The cell size is the main tuning knob. Large cells make each bucket hold many points,
+// so queries return more candidates that are actually far away. Small cells keep buckets tight,
+// but two close points on either side of a cell edge end up in different buckets;
+// a query that must not miss neighbours has to look at the adjacent cells too.
+type SpatialMap struct {
+	cellSize float64
+	buckets  map[string][]Vertex
+}
+
+func NewSpatialMap(cellSize float64) *SpatialMap {
+	return &SpatialMap{cellSize: cellSize, buckets: make(map[string][]Vertex)}
+}
+
+func (m *SpatialMap) Insert(v Vertex) {
+	key := v.Hash(m.cellSize)
+	m.buckets[key] = append(m.buckets[key], v)
+}
+
+// Query returns every vertex stored in the same cell as p.
+func (m *SpatialMap) Query(p Vertex) []Vertex {
+	return m.buckets[p.Hash(m.cellSize)]
+}
+
+func DemoSpatialMap() {
+	m := NewSpatialMap(10)
+	for _, v := range []Vertex{{X: 1, Y: 1}, {X: 3, Y: 4}, {X: 9.9, Y: 9.9}, {X: 10.1, Y: 9.9}, {X: 55, Y: 20}} {
+		m.Insert(v)
+	}
+
+	fmt.Println("Hash of Vertex(3, 4):", Vertex{X: 3, Y: 4}.Hash(10))
+	fmt.Println("Near Vertex(5, 5):", m.Query(Vertex{X: 5, Y: 5}))
+	fmt.Println("Near Vertex(50, 25):", m.Query(Vertex{X: 50, Y: 25}))
+
+	// Vertex(9.9, 9.9) and Vertex(10.1, 9.9) are only 0.2 apart, but a cell edge separates them.
+	fmt.Println("Near Vertex(10.1, 9.9):", m.Query(Vertex{X: 10.1, Y: 9.9}))
+}
@@ -0,0 +1,13 @@
+package methods
+
+import "fmt"
+
+func DemoHypot() {
+	small := Vertex{X: 3, Y: 4}
+	fmt.Println("Absolute vs AbsoluteHypot for Vertex(3, 4):", small.Absolute(), small.AbsoluteHypot())
+
+	// 3e200 squared is 9e400, far beyond the largest float64 (about 1.8e308).
+	huge := Vertex{X: 3e200, Y: 4e200}
+	fmt.Println("Absolute for Vertex(3e200, 4e200):", huge.Absolute())
+	fmt.Println("AbsoluteHypot for Vertex(3e200, 4e200):", huge.AbsoluteHypot())
+}
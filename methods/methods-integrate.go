@@ -0,0 +1,50 @@
+package methods
+
+import "fmt"
+
+// Numerical integration advances a moving point through small time steps dt,
+// given its acceleration as a function of position.
+//
+// Euler's method uses the slopes at the start of the step only, so its error grows with every step.
+// The classic fourth-order Runge-Kutta method (RK4) samples the slopes four times per step and combines them,
+// which makes it far more accurate for the same dt (exact, in fact, for constant acceleration).
+
+// IntegrateEuler advances pos and vel by one explicit Euler step.
+func IntegrateEuler(pos, vel Vertex, accel func(Vertex) Vertex, dt float64) (Vertex, Vertex) {
+	return pos.Add(vel.Scale(dt)), vel.Add(accel(pos).Scale(dt))
+}
+
+// IntegrateRK4 advances pos and vel by one fourth-order Runge-Kutta step.
+func IntegrateRK4(pos, vel Vertex, accel func(Vertex) Vertex, dt float64) (Vertex, Vertex) {
+	// Each k is a (velocity, acceleration) slope pair evaluated at a trial state.
+	k1v, k1a := vel, accel(pos)
+	k2v, k2a := vel.Add(k1a.Scale(dt/2)), accel(pos.Add(k1v.Scale(dt/2)))
+	k3v, k3a := vel.Add(k2a.Scale(dt/2)), accel(pos.Add(k2v.Scale(dt/2)))
+	k4v, k4a := vel.Add(k3a.Scale(dt)), accel(pos.Add(k3v.Scale(dt)))
+
+	newPos := pos.Add(k1v.Add(k2v.Scale(2)).Add(k3v.Scale(2)).Add(k4v).Scale(dt / 6))
+	newVel := vel.Add(k1a.Add(k2a.Scale(2)).Add(k3a.Scale(2)).Add(k4a).Scale(dt / 6))
+	return newPos, newVel
+}
+
+func DemoIntegration() {
+	gravity := Vertex{X: 0, Y: -9.8}
+	accel := func(Vertex) Vertex { return gravity }
+
+	start, launch := Vertex{X: 0, Y: 0}, Vertex{X: 10, Y: 20}
+	const steps, dt = 200, 0.01
+	t := steps * dt
+
+	eulerPos, eulerVel := start, launch
+	rk4Pos, rk4Vel := start, launch
+	for range steps {
+		eulerPos, eulerVel = IntegrateEuler(eulerPos, eulerVel, accel, dt)
+		rk4Pos, rk4Vel = IntegrateRK4(rk4Pos, rk4Vel, accel, dt)
+	}
+
+	// Under constant acceleration the exact answer is p = p0 + v0*t + a*t²/2.
+	exact := start.Add(launch.Scale(t)).Add(gravity.Scale(t * t / 2))
+	fmt.Printf("After %.0f seconds: exact %v\n", t, exact)
+	fmt.Printf("Euler %v (error %.4f)\n", eulerPos, eulerPos.Distance(exact))
+	fmt.Printf("RK4   %v (error %.4f)\n", rk4Pos, rk4Pos.Distance(exact))
+}
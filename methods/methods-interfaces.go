@@ -25,6 +25,25 @@ func (v *Coordinate) Scale(f float64) {
 	v.Y = v.Y * f
 }
 
+// Clone returns a pointer to a new copy of the Coordinate, so changes through one pointer don't show up in the other.
+// Cloning a nil *Coordinate returns nil.
+func (v *Coordinate) Clone() *Coordinate {
+	if v == nil {
+		return nil
+	}
+	c := *v
+	return &c
+}
+
+// Equals compares the fields the pointers refer to, not the pointers themselves.
+// Two nil pointers are equal; a nil and a non-nil pointer are not.
+func (v *Coordinate) Equals(u *Coordinate) bool {
+	if v == nil || u == nil {
+		return v == u
+	}
+	return v.X == u.X && v.Y == u.Y
+}
+
 // An interface type is defined as a set of method signatures.
 // A value of interface type can hold any value that implements those methods.
 
@@ -81,6 +100,11 @@ func DemoImplementationMethodsAndInterface() {
 	Describe(a)
 	// DescribeGeneric(a)
 
+	// Assigning a pointer shares the Coordinate; Clone makes an independent copy.
+	clone := (&myCoordinate).Clone()
+	clone.Scale(2)
+	fmt.Println("Original and scaled clone:", &myCoordinate, clone, clone != &myCoordinate)
+
 	// In the following line, myCoordinate is a Coordinate (not *Coordinate) and does NOT implement Absoluteness.
 	// a = myCoordinate
 
@@ -90,6 +114,7 @@ func DemoImplementationMethodsAndInterface() {
 	// Note that an interface value that holds a nil concrete value is itself non-nil.
 	var b *Coordinate
 	Describe(b)
+	fmt.Println("String on a nil *Coordinate:", b.String())
 	// DescribeGeneric(b)
 	b.Abs()
 
@@ -112,3 +137,182 @@ func DemoImplementationMethodsAndInterface() {
 	i = "hello"
 	DescribeGeneric(i)
 }
+
+// A struct can embed an interface type, not just a struct type.
+// The interface's methods are promoted to the struct, so the struct satisfies the interface too,
+// delegating each call to whatever concrete value the embedded field holds.
+// This is a common way to wrap a value and override or decorate only some of its methods.
+
+type Wrapper struct {
+	Absoluteness
+	Label string
+}
+
+// A compile-time assertion: this line fails to build if Wrapper ever stops satisfying Absoluteness.
+var _ Absoluteness = Wrapper{}
+
+func DemoEmbeddedInterface() {
+	w := Wrapper{Absoluteness: MyFloat(-2.5), Label: "float"}
+	fmt.Println("Abs through Wrapper ("+w.Label+"):", w.Abs())
+
+	w = Wrapper{Absoluteness: &Coordinate{X: 6, Y: 8}, Label: "coordinate"}
+	fmt.Println("Abs through Wrapper ("+w.Label+"):", w.Abs())
+
+	// The Wrapper itself can be stored in an Absoluteness variable.
+	var a Absoluteness = w
+	fmt.Printf("Stored as Absoluteness: %T, Abs %v\n", a, a.Abs())
+
+	// Calling Abs on a Wrapper whose embedded interface is nil panics, just like calling it on a nil interface.
+	// var empty Wrapper
+	// empty.Abs()
+}
+
+// Comparing two pointers with == asks "do these point to the same variable?", not "are the values equal?".
+// Dereferencing first compares the struct values field by field, which is what we usually mean.
+
+func DemoCoordinateEquality() {
+	a := &Coordinate{X: 1, Y: 2}
+	b := &Coordinate{X: 1, Y: 2}
+	c := a
+
+	fmt.Println("a == b (different pointers, same fields):", a == b)
+	fmt.Println("*a == *b (value comparison):", *a == *b)
+	fmt.Println("a == c (same pointer):", a == c)
+	fmt.Println("a.Equals(b):", a.Equals(b))
+
+	var n1, n2 *Coordinate
+	fmt.Println("nil.Equals(nil):", n1.Equals(n2))
+	fmt.Println("a.Equals(nil):", a.Equals(n1))
+	fmt.Println("nil.Equals(a):", n1.Equals(a))
+
+	// *n1 == *a would panic here: dereferencing a nil pointer is a run-time error.
+}
+
+// When a struct embeds two types that both have a method with the same name at the same depth,
+// neither is promoted: the selector is ambiguous. Declaring such a struct is fine;
+// the compile error only appears where the ambiguous name is used.
+// Qualifying the call with the embedded field's name (its type name) picks one explicitly.
+
+type BothFloats struct {
+	MyFloat
+	MyCustomFloat
+}
+
+func DemoPromotionConflict() {
+	b := BothFloats{MyFloat: -1.5, MyCustomFloat: -2.5}
+
+	// b.Abs()                -> compile error: ambiguous selector b.Abs
+	// var _ Absoluteness = b -> compile error: BothFloats does not implement Absoluteness (missing method Abs)
+	fmt.Println("b.MyFloat.Abs():", b.MyFloat.Abs())
+	fmt.Println("b.MyCustomFloat.Abs():", b.MyCustomFloat.Abs())
+
+	// A method declared on the outer type sits at a shallower depth, so it wins and resolves the ambiguity.
+	fmt.Println("PreferCustom.Abs():", PreferCustom{b}.Abs())
+}
+
+type PreferCustom struct {
+	BothFloats
+}
+
+func (p PreferCustom) Abs() float64 {
+	return p.MyCustomFloat.Abs()
+}
+
+// Embedding a pointer type promotes its methods just like embedding a value.
+// If the embedded pointer is nil, calling a promoted method still works: Go passes the nil pointer
+// as the receiver, and Coordinate.Abs checks for that. Only touching a promoted field
+// (which has to dereference the pointer) panics.
+
+type Marker struct {
+	*Coordinate
+	Name string
+}
+
+func DemoEmbeddedNilPointer() {
+	m := Marker{Name: "unplaced"}
+	fmt.Println("Embedded pointer is nil:", m.Coordinate == nil)
+	// Abs prints its own "<nil>" line before returning 0.
+	fmt.Println("Promoted Abs on nil embedded pointer:", m.Abs())
+	fmt.Println("Promoted String on nil embedded pointer:", m.String())
+
+	// m.X would panic: it means m.Coordinate.X, a nil pointer dereference.
+
+	m.Coordinate = &Coordinate{X: 5, Y: 12}
+	fmt.Println("After placing the marker:", m.Abs())
+}
+
+// Interfaces can embed other interfaces: Transformable's method set is the union of Absoluteness and Scaler.
+// Small interfaces composed this way (like io.ReadWriter from io.Reader and io.Writer) let each function
+// ask for exactly the behaviour it needs.
+
+type Scaler interface {
+	Scale(f float64)
+}
+
+type Transformable interface {
+	Absoluteness
+	Scaler
+}
+
+// *Coordinate has both Abs and Scale, so it satisfies Transformable; this line checks that at compile time.
+// Vertex doesn't: its Scale returns a new Vertex, so the signature doesn't match Scaler's.
+var _ Transformable = (*Coordinate)(nil)
+
+// ScaleAndMeasure scales t in place and returns its new magnitude.
+func ScaleAndMeasure(t Transformable, f float64) float64 {
+	t.Scale(f)
+	return t.Abs()
+}
+
+func DemoInterfaceComposition() {
+	c := &Coordinate{X: 3, Y: 4}
+	fmt.Println("Abs before scaling:", c.Abs())
+	fmt.Println("Abs after ScaleAndMeasure(c, 3):", ScaleAndMeasure(c, 3))
+	fmt.Println("Coordinate was scaled in place:", c)
+}
+
+// A type switch is like a regular switch, but its cases are types instead of values.
+// It compares the dynamic type of the interface value against each case in turn;
+// a case listing one type also gives the variable that type, while a case listing several keeps it as interface{}.
+
+func ClassifyValue(i interface{}) string {
+	switch i.(type) {
+	case int, int8, int16, int32, int64:
+		return "integer"
+	case string:
+		return "string"
+	case Vertex:
+		return "vertex"
+	case Coordinate, *Coordinate:
+		return "coordinate"
+	default:
+		// Includes a nil interface{}, which has no dynamic type at all.
+		return "unknown"
+	}
+}
+
+func DemoTypeSwitch() {
+	values := []interface{}{42, int64(-7), "hello", Vertex{X: 1, Y: 2}, &Coordinate{X: 3, Y: 4}, 3.14, nil}
+	for _, v := range values {
+		fmt.Printf("%v (%T): %s\n", v, v, ClassifyValue(v))
+	}
+}
+
+// A type assertion i.(T) extracts the concrete T from an interface value, and panics if i doesn't hold a T.
+// The two-result "comma ok" form never panics: on a mismatch it returns T's zero value and false.
+// The asserted type must match exactly, so a *Vertex inside the interface is not a Vertex.
+
+func AsVertex(i interface{}) (Vertex, bool) {
+	v, ok := i.(Vertex)
+	return v, ok
+}
+
+func DemoTypeAssertion() {
+	for _, i := range []interface{}{Vertex{X: 3, Y: 4}, &Vertex{X: 3, Y: 4}, "3,4"} {
+		v, ok := AsVertex(i)
+		fmt.Printf("AsVertex(%T): %v %v\n", i, v, ok)
+	}
+
+	// The single-result form panics on a mismatch:
+	// v := interface{}("3,4").(Vertex) -> panic: interface conversion: interface {} is string, not methods.Vertex
+}
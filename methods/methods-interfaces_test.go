@@ -0,0 +1,74 @@
+package methods
+
+import "testing"
+
+func TestCoordinateClone(t *testing.T) {
+	original := &Coordinate{X: 3, Y: 4}
+	clone := original.Clone()
+	if clone == original {
+		t.Fatal("Clone returned the same pointer")
+	}
+	if *clone != *original {
+		t.Fatalf("Clone() = %v, want a copy of %v", *clone, *original)
+	}
+
+	clone.Scale(2)
+	if want := (Coordinate{X: 3, Y: 4}); *original != want {
+		t.Errorf("scaling the clone changed the original to %v", *original)
+	}
+	original.X = -1
+	if clone.X != 6 {
+		t.Errorf("changing the original changed the clone's X to %v", clone.X)
+	}
+
+	var nilCoordinate *Coordinate
+	if got := nilCoordinate.Clone(); got != nil {
+		t.Errorf("Clone of a nil *Coordinate = %v, want nil", got)
+	}
+}
+
+func TestCoordinateIsTransformable(t *testing.T) {
+	var tr Transformable = &Coordinate{X: 3, Y: 4}
+	if got := ScaleAndMeasure(tr, 2); got != 10 {
+		t.Errorf("ScaleAndMeasure(&Coordinate{3, 4}, 2) = %v, want 10", got)
+	}
+}
+
+func TestClassifyValue(t *testing.T) {
+	tests := []struct {
+		name string
+		in   interface{}
+		want string
+	}{
+		{"int", 42, "integer"},
+		{"int64", int64(-7), "integer"},
+		{"int8", int8(1), "integer"},
+		{"string", "hello", "string"},
+		{"Vertex", Vertex{X: 1, Y: 2}, "vertex"},
+		{"Coordinate", Coordinate{X: 1, Y: 2}, "coordinate"},
+		{"*Coordinate", &Coordinate{X: 3, Y: 4}, "coordinate"},
+		{"float64", 3.14, "unknown"},
+		{"*Vertex", &Vertex{}, "unknown"},
+		{"nil", nil, "unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ClassifyValue(tt.in); got != tt.want {
+				t.Errorf("ClassifyValue(%#v) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAsVertex(t *testing.T) {
+	if v, ok := AsVertex(Vertex{X: 3, Y: 4}); !ok || v != (Vertex{X: 3, Y: 4}) {
+		t.Errorf("AsVertex(Vertex{3, 4}) = %v, %v, want Vertex(3, 4), true", v, ok)
+	}
+	// The assertion is on the exact dynamic type, so a *Vertex is not a Vertex.
+	if v, ok := AsVertex(&Vertex{X: 3, Y: 4}); ok || v != (Vertex{}) {
+		t.Errorf("AsVertex(&Vertex{3, 4}) = %v, %v, want the zero Vertex, false", v, ok)
+	}
+	if v, ok := AsVertex("3,4"); ok || v != (Vertex{}) {
+		t.Errorf("AsVertex(\"3,4\") = %v, %v, want the zero Vertex, false", v, ok)
+	}
+}
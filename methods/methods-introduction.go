@@ -11,7 +11,8 @@ import (
 // The following method has a receiver of type Vertex named v.
 
 type Vertex struct {
-	X, Y float64
+	X float64 `json:"x" desc:"x-coordinate"`
+	Y float64 `json:"y" desc:"y-coordinate"`
 }
 
 func (v Vertex) Absolute() float64 {
@@ -74,6 +75,12 @@ func (v *Vertex) ScaleWithPointer(f float64) {
 	v.Y = v.Y * f
 }
 
+// Translate moves the vertex in place, so like ScaleWithPointer it needs a pointer receiver.
+func (v *Vertex) Translate(dx, dy float64) {
+	v.X = v.X + dx
+	v.Y = v.Y + dy
+}
+
 // The above methods as functions
 func ScaleWithValueFunction(v Vertex, f float64) {
 	v.X = v.X * f
@@ -93,10 +100,39 @@ func DemoImplementationMethodsIntroduction() {
 	myCustomFloat := MyCustomFloat(-10)
 	fmt.Println("Abs method call (v1):", myCustomFloat.Abs())
 
+	// Value-receiver methods that return a new Vertex can be chained (see methods-vector.go).
+	offset := Vertex{X: -1, Y: 2}
+	fmt.Println("Add (v1 + offset):", v1.Add(offset))
+	fmt.Println("Subtract (v1 - offset):", v1.Subtract(offset))
+	fmt.Println("Chained (v1 + v1).Absolute():", v1.Add(v1).Absolute())
+	fmt.Println("Dot (v1 . offset):", v1.Dot(offset))
+	fmt.Println("Dot (v1 . v1) and Absolute squared:", v1.Dot(v1), v1.Absolute()*v1.Absolute())
+	fmt.Println("Dot with the zero vertex:", Vertex{}.Dot(Vertex{}))
+
+	// Normalize can fail, so it returns an error alongside the result.
+	if unit, err := v1.Normalize(); err == nil {
+		fmt.Println("Normalize (v1):", unit, unit.Absolute())
+	}
+	if _, err := (Vertex{}).Normalize(); err != nil {
+		fmt.Println("Normalize (zero vertex):", err)
+	}
+
+	// Floating point arithmetic rounds, so compare computed values with a tolerance rather than ==.
+	a, b := 0.1, 0.2
+	sum := Vertex{X: a + b, Y: 0}
+	fmt.Println("== (0.1+0.2 vs 0.3):", sum == Vertex{X: 0.3, Y: 0})
+	fmt.Println("Equals (0.1+0.2 vs 0.3, epsilon 1e-9):", sum.Equals(Vertex{X: 0.3, Y: 0}, 1e-9))
+
+	origin := Vertex{X: 0, Y: 0}
+	fmt.Println("Distance (origin to v1):", origin.Distance(v1))
+	fmt.Println("Distance (v1 to origin):", v1.Distance(origin))
+
 	v1.ScaleWithValue(10)
 	fmt.Println("Value receiver method call (v1):", v1, v1.Absolute())
 	v1.ScaleWithPointer(10)
 	fmt.Println("Pointer receiver method call (v1):", v1, v1.Absolute())
+	v1.Translate(-27, -36)
+	fmt.Println("Pointer receiver Translate (v1):", v1, v1.Absolute())
 
 	//Reset v1
 	v1 = Vertex{X: 3, Y: 4}
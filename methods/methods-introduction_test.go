@@ -0,0 +1,67 @@
+package methods
+
+import "testing"
+
+func TestAbsolute(t *testing.T) {
+	tests := []struct {
+		name string
+		v    Vertex
+		want float64
+	}{
+		{"zero", Vertex{}, 0},
+		{"3-4-5", Vertex{X: 3, Y: 4}, 5},
+		{"negative components", Vertex{X: -3, Y: -4}, 5},
+		{"on an axis", Vertex{X: 0, Y: -7}, 7},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.v.Absolute(); got != tt.want {
+				t.Errorf("%v.Absolute() = %v, want %v", tt.v, got, tt.want)
+			}
+			if got := AbsoluteFunction(tt.v); got != tt.want {
+				t.Errorf("AbsoluteFunction(%v) = %v, want %v", tt.v, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMyCustomFloatAbs(t *testing.T) {
+	tests := []struct {
+		f    MyCustomFloat
+		want float64
+	}{
+		{-10, 10},
+		{-0.5, 0.5},
+		{0, 0},
+		{2.5, 2.5},
+	}
+	for _, tt := range tests {
+		if got := tt.f.Abs(); got != tt.want {
+			t.Errorf("MyCustomFloat(%v).Abs() = %v, want %v", float64(tt.f), got, tt.want)
+		}
+	}
+}
+
+func TestScaleWithValueDoesNotMutate(t *testing.T) {
+	v := Vertex{X: 3, Y: 4}
+	v.ScaleWithValue(10)
+	if want := (Vertex{X: 3, Y: 4}); v != want {
+		t.Errorf("after ScaleWithValue(10), v = %v, want it unchanged at %v", v, want)
+	}
+}
+
+func TestScaleWithPointerMutates(t *testing.T) {
+	v := Vertex{X: 3, Y: 4}
+	v.ScaleWithPointer(10)
+	if want := (Vertex{X: 30, Y: 40}); v != want {
+		t.Errorf("after ScaleWithPointer(10), v = %v, want %v", v, want)
+	}
+}
+
+func TestTranslate(t *testing.T) {
+	v := Vertex{X: 3, Y: 4}
+	v.Translate(-27, 36)
+	if want := (Vertex{X: -24, Y: 40}); v != want {
+		t.Errorf("after Translate(-27, 36), v = %v, want %v", v, want)
+	}
+}
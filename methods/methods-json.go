@@ -0,0 +1,181 @@
+package methods
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+)
+
+// Any type with a MarshalJSON method satisfies json.Marshaler,
+// and encoding/json calls that method instead of using its default reflection-based encoding.
+
+// RoundedVertex wraps a Vertex and marshals its coordinates rounded to Decimals places,
+// so tiny floating point noise doesn't leak into serialized output.
+// It only customizes encoding: the output {"x":...,"y":...} uses the same keys as Vertex's json tags,
+// so it decodes straight back into a plain Vertex, but the digits dropped by rounding are gone for good.
+type RoundedVertex struct {
+	Vertex
+	Decimals int
+}
+
+func (r RoundedVertex) MarshalJSON() ([]byte, error) {
+	scale := math.Pow(10, float64(r.Decimals))
+	return json.Marshal(struct {
+		X float64 `json:"x"`
+		Y float64 `json:"y"`
+	}{
+		X: math.Round(r.X*scale) / scale,
+		Y: math.Round(r.Y*scale) / scale,
+	})
+}
+
+func DemoRoundedJSON() {
+	// Constant expressions are evaluated exactly at compile time, so use variables to get real float64 noise.
+	a, b, c := 0.1, 0.2, 3.0
+	noisy := Vertex{X: a + b, Y: 1 / c}
+
+	raw, _ := json.Marshal(noisy)
+	fmt.Println("Plain Vertex JSON:", string(raw))
+
+	rounded, _ := json.Marshal(RoundedVertex{Vertex: noisy, Decimals: 2})
+	fmt.Println("RoundedVertex JSON:", string(rounded))
+
+	var decoded Vertex
+	if err := json.Unmarshal(rounded, &decoded); err != nil {
+		fmt.Println("Unmarshal error:", err)
+		return
+	}
+	fmt.Println("Decoded back into a Vertex:", decoded)
+}
+
+// The json struct tag controls how encoding/json treats each field:
+//
+//	json:"x"            rename the key
+//	json:"x,omitempty"  leave the field out when it holds its zero value
+//	json:",string"      encode a number or bool as a JSON string
+//	json:"-"            never encode or decode the field
+//	json:"-,"           use "-" as the key name
+//
+// Vertex's own tags only rename X and Y. omitempty would be a poor fit there:
+// 0 is a perfectly good coordinate, and Vertex{X: 0, Y: 5} would lose its x key.
+
+type LabelledVertex struct {
+	X      float64 `json:"x,omitempty"`
+	Y      float64 `json:"y,omitempty"`
+	Label  string  `json:"label,omitempty"`
+	ID     int     `json:"id,string"`
+	Secret string  `json:"-"`
+	Dash   string  `json:"-,"`
+}
+
+func DemoJSONTags() {
+	variants := []interface{}{
+		Vertex{},
+		Vertex{X: 0, Y: 5},
+		LabelledVertex{},
+		LabelledVertex{X: 0, Y: 5, Label: "north", ID: 7, Secret: "not serialized", Dash: "dash"},
+	}
+	for _, v := range variants {
+		b, err := json.Marshal(v)
+		if err != nil {
+			fmt.Println("Marshal error:", err)
+			continue
+		}
+		fmt.Printf("%T: %s\n", v, b)
+	}
+}
+
+// Vertex implements json.Marshaler and json.Unmarshaler itself, so it controls its wire format exactly:
+// {"x":3,"y":4}. Decoding is stricter than the default: both keys must be present.
+//
+// vertexJSON has the same fields but none of Vertex's methods, so encoding it inside MarshalJSON
+// doesn't call MarshalJSON again (which would recurse forever).
+//
+// Like the standard decoders, UnmarshalJSON treats the JSON literal null as "no value" and leaves v unchanged.
+
+type vertexJSON struct {
+	X *float64 `json:"x"`
+	Y *float64 `json:"y"`
+}
+
+func (v Vertex) MarshalJSON() ([]byte, error) {
+	return json.Marshal(vertexJSON{X: &v.X, Y: &v.Y})
+}
+
+func (v *Vertex) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var decoded vertexJSON
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	if decoded.X == nil || decoded.Y == nil {
+		return fmt.Errorf("vertex JSON %s: want both \"x\" and \"y\"", data)
+	}
+	v.X, v.Y = *decoded.X, *decoded.Y
+	return nil
+}
+
+// Methods are promoted through embedding, MarshalJSON and UnmarshalJSON included. Without methods of its own,
+// a struct that embeds Vertex would encode as just {"x":...,"y":...} and silently drop its other fields,
+// and decoding would never fill them in. So each embedding type decides for itself:
+// RoundedVertex has its own MarshalJSON above, and NamedVertex spells out its fields below.
+// CountingVertex needs nothing: its only other field is unexported, which encoding/json skips anyway.
+
+func (n NamedVertex) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Name string
+		X    float64 `json:"x"`
+		Y    float64 `json:"y"`
+	}{Name: n.Name, X: n.X, Y: n.Y})
+}
+
+func (n *NamedVertex) UnmarshalJSON(data []byte) error {
+	var named struct{ Name string }
+	if err := json.Unmarshal(data, &named); err != nil {
+		return err
+	}
+	if err := n.Vertex.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	if string(data) != "null" {
+		n.Name = named.Name
+	}
+	return nil
+}
+
+// An Easing func is code, not data, and encoding/json can't encode funcs at all,
+// so an AnimatedVertex deliberately encodes as its position alone.
+func (a AnimatedVertex) MarshalJSON() ([]byte, error) {
+	return a.Vertex.MarshalJSON()
+}
+
+func DemoJSON() {
+	original := Vertex{X: 3, Y: 4}
+	data, err := json.Marshal(original)
+	if err != nil {
+		fmt.Println("Marshal error:", err)
+		return
+	}
+	fmt.Println("Marshaled:", string(data))
+
+	var decoded Vertex
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		fmt.Println("Unmarshal error:", err)
+		return
+	}
+	fmt.Println("Round trip:", decoded, decoded == original)
+
+	for _, bad := range []string{`{"x":3`, `{"x":"three","y":4}`, `{"x":3}`} {
+		var v Vertex
+		fmt.Printf("Unmarshal %s: %v\n", bad, json.Unmarshal([]byte(bad), &v))
+	}
+
+	// NamedVertex has its own MarshalJSON, so the promoted one from Vertex doesn't hide its Name.
+	named, _ := json.Marshal(NamedVertex{Name: "corner", Vertex: original})
+	fmt.Println("NamedVertex:", string(named))
+
+	// null leaves the Vertex as it was.
+	fmt.Println("Unmarshal null:", json.Unmarshal([]byte("null"), &decoded), decoded)
+}
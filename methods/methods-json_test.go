@@ -0,0 +1,130 @@
+package methods
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestVertexMarshalJSON(t *testing.T) {
+	tests := []struct {
+		v    Vertex
+		want string
+	}{
+		{Vertex{X: 3, Y: 4}, `{"x":3,"y":4}`},
+		{Vertex{}, `{"x":0,"y":0}`},
+		{Vertex{X: -1.5, Y: 0.25}, `{"x":-1.5,"y":0.25}`},
+	}
+	for _, tt := range tests {
+		got, err := json.Marshal(tt.v)
+		if err != nil {
+			t.Fatalf("json.Marshal(%v) returned error %v", tt.v, err)
+		}
+		if string(got) != tt.want {
+			t.Errorf("json.Marshal(%v) = %s, want %s", tt.v, got, tt.want)
+		}
+	}
+}
+
+func TestVertexUnmarshalJSON(t *testing.T) {
+	var v Vertex
+	if err := json.Unmarshal([]byte(`{"y":4,"x":3}`), &v); err != nil {
+		t.Fatalf("json.Unmarshal returned error %v", err)
+	}
+	if want := (Vertex{X: 3, Y: 4}); v != want {
+		t.Errorf("json.Unmarshal gave %v, want %v", v, want)
+	}
+}
+
+func TestVertexJSONRoundTrip(t *testing.T) {
+	for _, original := range []Vertex{{X: 3, Y: 4}, {}, {X: -1e-7, Y: 1e300}, {X: 0.1, Y: 1.0 / 3}} {
+		data, err := json.Marshal(original)
+		if err != nil {
+			t.Fatalf("json.Marshal(%v) returned error %v", original, err)
+		}
+		var decoded Vertex
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			t.Fatalf("json.Unmarshal(%s) returned error %v", data, err)
+		}
+		if decoded != original {
+			t.Errorf("round trip of %v gave %v", original, decoded)
+		}
+	}
+}
+
+func TestVertexUnmarshalJSONMalformed(t *testing.T) {
+	for _, input := range []string{
+		`{"x":3`,
+		`{"x":"three","y":4}`,
+		`{"x":3}`,
+		`{"y":4}`,
+		`{}`,
+		`[3,4]`,
+		`"3,4"`,
+	} {
+		v := Vertex{X: 7, Y: 7}
+		if err := json.Unmarshal([]byte(input), &v); err == nil {
+			t.Errorf("json.Unmarshal(%s) succeeded with %v, want an error", input, v)
+		}
+	}
+}
+
+func TestVertexUnmarshalJSONNull(t *testing.T) {
+	v := Vertex{X: 3, Y: 4}
+	if err := json.Unmarshal([]byte("null"), &v); err != nil {
+		t.Fatalf("json.Unmarshal(null) returned error %v", err)
+	}
+	if want := (Vertex{X: 3, Y: 4}); v != want {
+		t.Errorf("json.Unmarshal(null) changed v to %v, want it unchanged", v)
+	}
+
+	var p *Vertex
+	if err := json.Unmarshal([]byte("null"), &p); err != nil || p != nil {
+		t.Errorf("json.Unmarshal(null) into a *Vertex gave %v, %v, want nil, nil", p, err)
+	}
+}
+
+func TestEmbeddingTypesKeepTheirFields(t *testing.T) {
+	tests := []struct {
+		name string
+		v    interface{}
+		want string
+	}{
+		{"NamedVertex", NamedVertex{Name: "corner", Vertex: Vertex{X: 1, Y: 2}}, `{"Name":"corner","x":1,"y":2}`},
+		{"RoundedVertex", RoundedVertex{Vertex: Vertex{X: 1.234, Y: 2}, Decimals: 1}, `{"x":1.2,"y":2}`},
+		{"CountingVertex", &CountingVertex{Vertex: Vertex{X: 1, Y: 2}}, `{"x":1,"y":2}`},
+		{"AnimatedVertex", AnimatedVertex{Vertex: Vertex{X: 1, Y: 2}, Easing: func(t float64) float64 { return t }}, `{"x":1,"y":2}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.v)
+			if err != nil {
+				t.Fatalf("json.Marshal returned error %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("json.Marshal = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNamedVertexJSONRoundTrip(t *testing.T) {
+	original := NamedVertex{Name: "corner", Vertex: Vertex{X: 1, Y: 2}}
+	data, err := json.Marshal(original)
+	if err != nil {
+		t.Fatalf("json.Marshal returned error %v", err)
+	}
+	var decoded NamedVertex
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("json.Unmarshal(%s) returned error %v", data, err)
+	}
+	if decoded != original {
+		t.Errorf("round trip gave %+v, want %+v", decoded, original)
+	}
+
+	if err := json.Unmarshal([]byte(`{"Name":"edge","x":1}`), &decoded); err == nil {
+		t.Error("json.Unmarshal of a NamedVertex missing \"y\" succeeded, want an error")
+	}
+	if err := json.Unmarshal([]byte("null"), &decoded); err != nil || decoded != original {
+		t.Errorf("json.Unmarshal(null) gave %+v, %v, want it unchanged", decoded, err)
+	}
+}
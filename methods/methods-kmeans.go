@@ -0,0 +1,99 @@
+package methods
+
+import "fmt"
+
+// KMeans partitions points into k clusters using Lloyd's algorithm:
+// assign every point to its nearest centroid, move each centroid to the Centroid of its points, and repeat
+// until the assignments stop changing or maxIter rounds have run.
+// A last assignment pass after the loop makes the returned assignments match the returned centroids.
+//
+// The first k points are used as the initial centroids, which keeps the result deterministic
+// (random or k-means++ seeding usually gives better clusters). If a cluster ends up empty,
+// it is reseeded with the point farthest from the centroid it was just assigned to,
+// and no point reseeds two clusters, so all k centroids stay in use.
+// ok is false when k is not in [1, len(points)], or when maxIter is less than 1,
+// since then no point would ever be assigned to a cluster.
+func KMeans(points []Vertex, k int, maxIter int) (centroids []Vertex, assignments []int, ok bool) {
+	if k < 1 || k > len(points) || maxIter < 1 {
+		return nil, nil, false
+	}
+
+	centroids = append([]Vertex(nil), points[:k]...)
+	assignments = make([]int, len(points))
+	for i := range assignments {
+		assignments[i] = -1
+	}
+
+	for iter := 0; iter < maxIter; iter++ {
+		if !assignNearest(points, centroids, assignments) {
+			break
+		}
+
+		members := make([][]Vertex, k)
+		for i, p := range points {
+			members[assignments[i]] = append(members[assignments[i]], p)
+		}
+		// Reseeding measures against the centroids the points were just assigned to,
+		// not the partly updated slice being written below.
+		assignedTo := append([]Vertex(nil), centroids...)
+		reseeds := make(map[int]bool)
+		for c := range centroids {
+			if centroid, ok := Centroid(members[c]); ok {
+				centroids[c] = centroid
+			} else {
+				i := farthestPoint(points, assignedTo, assignments, reseeds)
+				reseeds[i] = true
+				centroids[c] = points[i]
+			}
+		}
+	}
+	assignNearest(points, centroids, assignments)
+	return centroids, assignments, true
+}
+
+// assignNearest sets each assignment to the index of the nearest centroid and reports whether any changed.
+func assignNearest(points, centroids []Vertex, assignments []int) bool {
+	changed := false
+	for i, p := range points {
+		nearest := 0
+		for c := 1; c < len(centroids); c++ {
+			if p.Distance(centroids[c]) < p.Distance(centroids[nearest]) {
+				nearest = c
+			}
+		}
+		if assignments[i] != nearest {
+			assignments[i] = nearest
+			changed = true
+		}
+	}
+	return changed
+}
+
+// farthestPoint returns the index of the point farthest from the centroid it is assigned to,
+// skipping the indices in exclude.
+func farthestPoint(points, centroids []Vertex, assignments []int, exclude map[int]bool) int {
+	best, bestDistance := -1, -1.0
+	for i, p := range points {
+		if exclude[i] {
+			continue
+		}
+		if d := p.Distance(centroids[assignments[i]]); d > bestDistance {
+			best, bestDistance = i, d
+		}
+	}
+	return best
+}
+
+func DemoKMeans() {
+	points := []Vertex{
+		{X: 1, Y: 1}, {X: 9, Y: 9}, {X: 1.5, Y: 2}, {X: 2, Y: 1},
+		{X: 8, Y: 9.5}, {X: 9.5, Y: 8}, {X: 1, Y: 1.5}, {X: 8.5, Y: 8.5},
+	}
+
+	centroids, assignments, ok := KMeans(points, 2, 10)
+	fmt.Println("Centroids:", centroids, "ok:", ok)
+	fmt.Println("Assignments:", assignments)
+
+	_, _, ok = KMeans(points, 20, 10)
+	fmt.Println("k larger than the number of points ok:", ok)
+}
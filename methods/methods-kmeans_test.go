@@ -0,0 +1,98 @@
+package methods
+
+import "testing"
+
+// nearestCentroid returns the index of the centroid closest to p, preferring the lower index on ties.
+func nearestCentroid(p Vertex, centroids []Vertex) int {
+	nearest := 0
+	for c := 1; c < len(centroids); c++ {
+		if p.Distance(centroids[c]) < p.Distance(centroids[nearest]) {
+			nearest = c
+		}
+	}
+	return nearest
+}
+
+func checkAssignedToNearest(t *testing.T, points, centroids []Vertex, assignments []int) {
+	t.Helper()
+	if len(assignments) != len(points) {
+		t.Fatalf("got %d assignments for %d points", len(assignments), len(points))
+	}
+	for i, p := range points {
+		if want := nearestCentroid(p, centroids); assignments[i] != want {
+			t.Errorf("%v is assigned to centroid %d, but its nearest centroid is %d (centroids %v)", p, assignments[i], want, centroids)
+		}
+	}
+}
+
+func TestKMeansTwoGroups(t *testing.T) {
+	points := []Vertex{
+		{X: 1, Y: 1}, {X: 9, Y: 9}, {X: 1.5, Y: 2}, {X: 2, Y: 1},
+		{X: 8, Y: 9.5}, {X: 9.5, Y: 8}, {X: 1, Y: 1.5}, {X: 8.5, Y: 8.5},
+	}
+	centroids, assignments, ok := KMeans(points, 2, 10)
+	if !ok {
+		t.Fatal("KMeans(points, 2, 10) returned ok=false")
+	}
+	if len(centroids) != 2 {
+		t.Fatalf("got %d centroids, want 2", len(centroids))
+	}
+	checkAssignedToNearest(t, points, centroids, assignments)
+	for i, p := range points {
+		// Points in the same corner of the plane share a cluster.
+		if low := p.X < 5; low != (assignments[0] == assignments[i]) {
+			t.Errorf("%v is in cluster %d, but %v is in cluster %d", p, assignments[i], points[0], assignments[0])
+		}
+	}
+}
+
+func TestKMeansInvalidArguments(t *testing.T) {
+	points := []Vertex{{X: 1, Y: 1}, {X: 2, Y: 2}, {X: 9, Y: 9}}
+	tests := []struct {
+		name       string
+		k, maxIter int
+	}{
+		{"k zero", 0, 10},
+		{"k larger than the number of points", 4, 10},
+		{"maxIter zero", 2, 0},
+		{"maxIter negative", 2, -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			centroids, assignments, ok := KMeans(points, tt.k, tt.maxIter)
+			if ok || centroids != nil || assignments != nil {
+				t.Errorf("KMeans(points, %d, %d) = %v, %v, %v, want nil, nil, false", tt.k, tt.maxIter, centroids, assignments, ok)
+			}
+		})
+	}
+}
+
+func TestKMeansSingleIteration(t *testing.T) {
+	// One round moves the centroids after the points were assigned,
+	// so the returned assignments must come from a pass against the moved centroids.
+	points := []Vertex{{X: 0, Y: 0}, {X: 10, Y: 10}, {X: 1, Y: 0}, {X: 6, Y: 6}, {X: 9, Y: 10}}
+	centroids, assignments, ok := KMeans(points, 2, 1)
+	if !ok {
+		t.Fatal("KMeans(points, 2, 1) returned ok=false")
+	}
+	checkAssignedToNearest(t, points, centroids, assignments)
+}
+
+func TestKMeansReseedsEmptyClustersWithDistinctPoints(t *testing.T) {
+	// The first three points, which seed the centroids, coincide, so every point goes to cluster 0
+	// and clusters 1 and 2 both come up empty in the same round. A single round leaves no chance
+	// to recover from a bad reseed before KMeans returns.
+	points := []Vertex{{X: 0, Y: 0}, {X: 0, Y: 0}, {X: 0, Y: 0}, {X: 10, Y: 0}, {X: 20, Y: 0}}
+	centroids, assignments, ok := KMeans(points, 3, 1)
+	if !ok {
+		t.Fatal("KMeans(points, 3, 1) returned ok=false")
+	}
+	for a := range centroids {
+		for b := a + 1; b < len(centroids); b++ {
+			if centroids[a] == centroids[b] {
+				t.Errorf("centroids %d and %d are both %v", a, b, centroids[a])
+			}
+		}
+	}
+	checkAssignedToNearest(t, points, centroids, assignments)
+}
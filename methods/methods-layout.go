@@ -0,0 +1,44 @@
+package methods
+
+import (
+	"fmt"
+	"reflect"
+	"unsafe"
+)
+
+// The compiler lays struct fields out in declaration order, inserting padding so each field starts
+// at a multiple of its alignment (8 bytes for float64 on 64-bit platforms), and pads the end
+// so arrays of the struct stay aligned. Ordering fields from largest to smallest alignment minimizes that padding.
+//
+// unsafe.Sizeof and reflect's Field(i).Offset only report layout information here;
+// nothing reads or writes memory through unsafe pointers.
+
+type paddedFlags struct {
+	Visible bool
+	Weight  float64
+	Locked  bool
+}
+
+type packedFlags struct {
+	Weight  float64
+	Visible bool
+	Locked  bool
+}
+
+func describeLayout(name string, value interface{}, size uintptr) {
+	t := reflect.TypeOf(value)
+	fmt.Printf("%s: size %d, align %d\n", name, size, t.Align())
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		fmt.Printf("  %-8s offset %2d size %d\n", f.Name, f.Offset, f.Type.Size())
+	}
+}
+
+func DemoStructSize() {
+	describeLayout("Vertex", Vertex{}, unsafe.Sizeof(Vertex{}))
+	describeLayout("Coordinate", Coordinate{}, unsafe.Sizeof(Coordinate{}))
+	// 1 byte + 7 padding + 8 + 1 byte + 7 padding = 24 bytes.
+	describeLayout("paddedFlags", paddedFlags{}, unsafe.Sizeof(paddedFlags{}))
+	// 8 + 1 + 1 + 6 padding = 16 bytes, the same fields in a third less space.
+	describeLayout("packedFlags", packedFlags{}, unsafe.Sizeof(packedFlags{}))
+}
@@ -0,0 +1,64 @@
+package methods
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Conway's Game of Life on an unbounded grid, stored sparsely as the set of live cells.
+// Vertex works as a map key because it is comparable; with integer coordinates, == on the float fields is exact.
+// Only live cells and their neighbours can be alive in the next generation,
+// so counting neighbours for those is enough.
+
+var lifeNeighbours = []Vertex{
+	{X: -1, Y: -1}, {X: 0, Y: -1}, {X: 1, Y: -1},
+	{X: -1, Y: 0}, {X: 1, Y: 0},
+	{X: -1, Y: 1}, {X: 0, Y: 1}, {X: 1, Y: 1},
+}
+
+// LifeStep returns the next generation: a live cell survives with 2 or 3 live neighbours,
+// and a dead cell becomes alive with exactly 3.
+func LifeStep(alive map[Vertex]bool) map[Vertex]bool {
+	counts := make(map[Vertex]int)
+	for cell, ok := range alive {
+		if !ok {
+			continue
+		}
+		for _, d := range lifeNeighbours {
+			counts[Vertex{X: cell.X + d.X, Y: cell.Y + d.Y}]++
+		}
+	}
+
+	next := make(map[Vertex]bool)
+	for cell, n := range counts {
+		if n == 3 || (n == 2 && alive[cell]) {
+			next[cell] = true
+		}
+	}
+	return next
+}
+
+// renderLife draws the cells inside [0, size) x [0, size) as rows of '#' and '.'.
+func renderLife(alive map[Vertex]bool, size int) string {
+	var b strings.Builder
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			if alive[Vertex{X: float64(x), Y: float64(y)}] {
+				b.WriteByte('#')
+			} else {
+				b.WriteByte('.')
+			}
+		}
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+func DemoLife() {
+	// A blinker: three cells in a row flip between horizontal and vertical every generation.
+	alive := map[Vertex]bool{{X: 1, Y: 2}: true, {X: 2, Y: 2}: true, {X: 3, Y: 2}: true}
+	for gen := 0; gen < 3; gen++ {
+		fmt.Printf("Generation %d:\n%s", gen, renderLife(alive, 5))
+		alive = LifeStep(alive)
+	}
+}
@@ -0,0 +1,101 @@
+package methods
+
+import "fmt"
+
+// A singly linked list written for one concrete element type.
+// Every node holds a Vertex and a pointer to the next node; a nil pointer marks the end.
+
+type VertexNode struct {
+	Value Vertex
+	Next  *VertexNode
+}
+
+type VertexList struct {
+	head, tail *VertexNode
+}
+
+func (l *VertexList) Push(v Vertex) {
+	node := &VertexNode{Value: v}
+	if l.tail == nil {
+		l.head = node
+	} else {
+		l.tail.Next = node
+	}
+	l.tail = node
+}
+
+// The same list written once with a type parameter.
+// List[T] works for any element type; the compiler checks each use with the concrete type (List[Vertex], List[int]).
+// Compare with VertexList above: Push links nodes the same way, with T taking the place of Vertex.
+// The generic version also keeps its nodes unexported, counts its elements so Len is O(1),
+// and hands them out through Iterator instead of letting callers walk the nodes.
+
+type listNode[T any] struct {
+	value T
+	next  *listNode[T]
+}
+
+type List[T any] struct {
+	head, tail *listNode[T]
+	length     int
+}
+
+func (l *List[T]) Push(v T) {
+	node := &listNode[T]{value: v}
+	if l.tail == nil {
+		l.head = node
+	} else {
+		l.tail.next = node
+	}
+	l.tail = node
+	l.length++
+}
+
+func (l *List[T]) Len() int {
+	return l.length
+}
+
+// Iterator returns a closure that yields the elements in order.
+// Once the list is exhausted it returns the zero value of T and false.
+func (l *List[T]) Iterator() func() (T, bool) {
+	node := l.head
+	return func() (T, bool) {
+		if node == nil {
+			var zero T
+			return zero, false
+		}
+		v := node.value
+		node = node.next
+		return v, true
+	}
+}
+
+func DemoGenericList() {
+	// The concrete list only ever holds vertices.
+	var concrete VertexList
+	concrete.Push(Vertex{X: 1, Y: 2})
+	concrete.Push(Vertex{X: 3, Y: 4})
+	for n := concrete.head; n != nil; n = n.Next {
+		fmt.Println("VertexList element:", n.Value)
+	}
+
+	// The generic list can be instantiated with Vertex...
+	var vertices List[Vertex]
+	vertices.Push(Vertex{X: 1, Y: 2})
+	vertices.Push(Vertex{X: 3, Y: 4})
+	next := vertices.Iterator()
+	for v, ok := next(); ok; v, ok = next() {
+		fmt.Println("List[Vertex] element:", v, v.Absolute())
+	}
+
+	// ...or with int, without writing a second list type.
+	var ints List[int]
+	for i := 1; i <= 3; i++ {
+		ints.Push(i * 10)
+	}
+	nextInt := ints.Iterator()
+	for n, ok := nextInt(); ok; n, ok = nextInt() {
+		fmt.Println("List[int] element:", n)
+	}
+	fmt.Println("List[int] length:", ints.Len())
+}
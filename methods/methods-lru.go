@@ -0,0 +1,89 @@
+package methods
+
+import (
+	"container/list"
+	"fmt"
+)
+
+// A least-recently-used cache keeps at most capacity entries and, when full, evicts the one untouched for longest.
+// The map gives O(1) lookup by key; the doubly linked list from container/list keeps entries in recency order,
+// most recent at the front, so both moving an entry to the front and dropping the back are O(1) too.
+// K must be comparable because it is used as a map key.
+
+type lruEntry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+type LRU[K comparable, V any] struct {
+	capacity int
+	order    *list.List
+	items    map[K]*list.Element
+}
+
+func NewLRU[K comparable, V any](capacity int) *LRU[K, V] {
+	return &LRU[K, V]{
+		capacity: capacity,
+		order:    list.New(),
+		items:    make(map[K]*list.Element),
+	}
+}
+
+// Get returns the cached value for key and marks it as most recently used.
+func (c *LRU[K, V]) Get(key K) (V, bool) {
+	el, ok := c.items[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	c.order.MoveToFront(el)
+	return el.Value.(*lruEntry[K, V]).value, true
+}
+
+// Put stores value under key, evicting the least recently used entry if the cache is over capacity.
+func (c *LRU[K, V]) Put(key K, value V) {
+	if el, ok := c.items[key]; ok {
+		el.Value.(*lruEntry[K, V]).value = value
+		c.order.MoveToFront(el)
+		return
+	}
+
+	c.items[key] = c.order.PushFront(&lruEntry[K, V]{key: key, value: value})
+	if c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*lruEntry[K, V]).key)
+	}
+}
+
+func (c *LRU[K, V]) Len() int {
+	return c.order.Len()
+}
+
+func DemoLRU() {
+	cache := NewLRU[string, float64](2)
+
+	// Keys come from Vertex.Hash, which names the grid cell a vertex falls in, here with cells a millionth of a unit wide.
+	// Any two vertices in the same cell share a cache entry, however far apart they are within it,
+	// while two vertices a hair apart still miss if a cell boundary runs between them.
+	magnitude := func(v Vertex) float64 {
+		key := v.Hash(1e-6)
+		if m, ok := cache.Get(key); ok {
+			fmt.Println("Cache hit:", v)
+			return m
+		}
+		fmt.Println("Cache miss:", v)
+		m := v.Absolute()
+		cache.Put(key, m)
+		return m
+	}
+
+	a, b, c := Vertex{X: 3, Y: 4}, Vertex{X: 6, Y: 8}, Vertex{X: 5, Y: 12}
+	magnitude(a)
+	magnitude(b)
+	magnitude(a) // a is now the most recently used
+	magnitude(c) // over capacity: evicts b, the least recently used
+	magnitude(a)
+	magnitude(b)
+	fmt.Println("Entries cached:", cache.Len())
+}
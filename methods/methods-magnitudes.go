@@ -0,0 +1,33 @@
+package methods
+
+import "fmt"
+
+// Two ways to write "sum the magnitudes of anything with an Abs method".
+// SumMagnitudes takes a type parameter constrained by the interface, so it accepts a []MyFloat as-is.
+// SumMagnitudesIface takes interface values, so a []MyFloat has to be converted element by element first,
+// boxing each value into an interface.
+
+func SumMagnitudes[T Absoluteness](items []T) float64 {
+	total := 0.0
+	for _, item := range items {
+		total += item.Abs()
+	}
+	return total
+}
+
+func SumMagnitudesIface(items []Absoluteness) float64 {
+	total := 0.0
+	for _, item := range items {
+		total += item.Abs()
+	}
+	return total
+}
+
+func DemoSumMagnitudes() {
+	floats := []MyFloat{-1, 2, -3.5}
+	fmt.Println("SumMagnitudes[MyFloat]:", SumMagnitudes(floats))
+
+	// The interface version needs a new slice, and can then mix concrete types freely.
+	mixed := []Absoluteness{MyFloat(-1), MyFloat(2), &Coordinate{X: 3, Y: 4}}
+	fmt.Println("SumMagnitudesIface (mixed):", SumMagnitudesIface(mixed))
+}
@@ -0,0 +1,44 @@
+package methods
+
+import (
+	"fmt"
+	"sort"
+)
+
+// The order of a range over a map is unspecified, and the runtime deliberately randomizes it
+// so programs can't come to depend on one. Any output that must be stable needs its keys sorted explicitly.
+// Vertex works as a key here because it is comparable, and integer coordinates make == exact.
+
+func DemoMapOrder() {
+	labels := map[Vertex]string{
+		{X: 0, Y: 0}:  "origin",
+		{X: 1, Y: 0}:  "east",
+		{X: 0, Y: 1}:  "north",
+		{X: -1, Y: 0}: "west",
+		{X: 0, Y: -1}: "south",
+	}
+
+	// Run the program a few times: these lines usually come out in a different order each time.
+	for i := 0; i < 3; i++ {
+		var order []string
+		for _, label := range labels {
+			order = append(order, label)
+		}
+		fmt.Println("Range order:", order)
+	}
+
+	// Collect and sort the keys (by X, then Y) for a deterministic order.
+	keys := make([]Vertex, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].X != keys[j].X {
+			return keys[i].X < keys[j].X
+		}
+		return keys[i].Y < keys[j].Y
+	})
+	for _, k := range keys {
+		fmt.Println("Sorted:", k, labels[k])
+	}
+}
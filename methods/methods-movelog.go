@@ -0,0 +1,59 @@
+package methods
+
+import (
+	"fmt"
+	"time"
+)
+
+// A slice of small structs is often all the "database" a program needs.
+// MoveLog appends a timestamped entry for every position change and filters them on demand.
+
+type Move struct {
+	Time time.Time
+	Pos  Vertex
+}
+
+type MoveLog struct {
+	moves []Move
+}
+
+// Record logs pos at the current time.
+func (l *MoveLog) Record(pos Vertex) {
+	l.RecordAt(time.Now(), pos)
+}
+
+// RecordAt logs pos at an explicit time, which keeps demos (and tests) deterministic.
+func (l *MoveLog) RecordAt(t time.Time, pos Vertex) {
+	l.moves = append(l.moves, Move{Time: t, Pos: pos})
+}
+
+// Since returns the positions recorded at or after t, in insertion order.
+// That is oldest first only if the times were recorded in order: RecordAt accepts any time,
+// and Since does not sort.
+func (l *MoveLog) Since(t time.Time) []Vertex {
+	var positions []Vertex
+	for _, m := range l.moves {
+		if !m.Time.Before(t) {
+			positions = append(positions, m.Pos)
+		}
+	}
+	return positions
+}
+
+func DemoMoveLog() {
+	var log MoveLog
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	pos := Vertex{X: 0, Y: 0}
+	for i := 0; i < 5; i++ {
+		pos.Translate(1, 2)
+		log.RecordAt(start.Add(time.Duration(i)*time.Minute), pos)
+	}
+
+	cutoff := start.Add(3 * time.Minute)
+	fmt.Println("All moves:", log.Since(time.Time{}))
+	fmt.Println("Moves since", cutoff.Format("15:04")+":", log.Since(cutoff))
+
+	log.Record(Vertex{X: 100, Y: 100})
+	fmt.Println("Moves since 12:30:", log.Since(start.Add(30*time.Minute)))
+}
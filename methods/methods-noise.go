@@ -0,0 +1,62 @@
+package methods
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Value noise gives every integer grid corner a pseudo-random value and blends smoothly between corners,
+// producing a continuous random-looking field (terrain heights, textures, cloud cover).
+// The corner values come from hashing the corner's coordinates with the seed, so there is no state to store:
+// the same v and seed always give the same result, on every run and every machine,
+// and different seeds give unrelated fields.
+
+// cornerValue hashes an integer corner and seed to a value in [0, 1] (the splitmix64 finalizer).
+func cornerValue(x, y int64, seed int64) float64 {
+	h := uint64(x)*0x9E3779B97F4A7C15 ^ uint64(y)*0xC2B2AE3D27D4EB4F ^ uint64(seed)*0x165667B19E3779F9
+	h ^= h >> 30
+	h *= 0xBF58476D1CE4E5B9
+	h ^= h >> 27
+	h *= 0x94D049BB133111EB
+	h ^= h >> 31
+	return float64(h>>11) / float64(1<<53)
+}
+
+// smoothstep eases t so the blend has zero slope at each corner, hiding the grid lines.
+func smoothstep(t float64) float64 {
+	return t * t * (3 - 2*t)
+}
+
+// ValueNoise returns a smooth pseudo-random value in [0, 1] at v.
+func ValueNoise(v Vertex, seed int64) float64 {
+	x0, y0 := math.Floor(v.X), math.Floor(v.Y)
+	ix, iy := int64(x0), int64(y0)
+	sx, sy := smoothstep(v.X-x0), smoothstep(v.Y-y0)
+
+	// Packing the left corners into one Vertex and the right corners into another lets a single Lerp
+	// interpolate along the bottom edge (X) and the top edge (Y) at once; a second Lerp then blends between the edges.
+	left := Vertex{X: cornerValue(ix, iy, seed), Y: cornerValue(ix, iy+1, seed)}
+	right := Vertex{X: cornerValue(ix+1, iy, seed), Y: cornerValue(ix+1, iy+1, seed)}
+	edges := left.Lerp(right, sx)
+	return Vertex{X: edges.X}.Lerp(Vertex{X: edges.Y}, sy).X
+}
+
+func DemoValueNoise() {
+	const seed = 7
+	shades := " .:-=+*#%@"
+	for y := 0; y < 8; y++ {
+		var b strings.Builder
+		for x := 0; x < 32; x++ {
+			n := ValueNoise(Vertex{X: float64(x) / 4, Y: float64(y) / 4}, seed)
+			b.WriteByte(shades[int(n*float64(len(shades)-1)+0.5)])
+		}
+		fmt.Println(b.String())
+	}
+
+	p := Vertex{X: 2.3, Y: 5.7}
+	near := p.Add(Vertex{X: 0.001, Y: 0.001})
+	fmt.Printf("Continuity: |noise(p) - noise(p+0.001)| = %.6f\n", math.Abs(ValueNoise(p, seed)-ValueNoise(near, seed)))
+	fmt.Println("Deterministic:", ValueNoise(p, seed) == ValueNoise(p, seed))
+	fmt.Printf("Another seed at p: %.3f vs %.3f\n", ValueNoise(p, seed), ValueNoise(p, seed+1))
+}
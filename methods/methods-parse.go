@@ -0,0 +1,28 @@
+package methods
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseVertex parses a vertex written as "x,y", optionally wrapped in parentheses
+// and with spaces around the numbers, such as "(3, 4)".
+func ParseVertex(s string) (Vertex, error) {
+	body := strings.TrimSpace(s)
+	body = strings.TrimSuffix(strings.TrimPrefix(body, "("), ")")
+
+	parts := strings.Split(body, ",")
+	if len(parts) != 2 {
+		return Vertex{}, fmt.Errorf("parse vertex %q: want two comma-separated numbers", s)
+	}
+	x, err := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+	if err != nil {
+		return Vertex{}, fmt.Errorf("parse vertex %q: %w", s, err)
+	}
+	y, err := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+	if err != nil {
+		return Vertex{}, fmt.Errorf("parse vertex %q: %w", s, err)
+	}
+	return Vertex{X: x, Y: y}, nil
+}
@@ -0,0 +1,130 @@
+package methods
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+	"time"
+)
+
+// A Particle is a point mass moving with constant velocity until something acts on it.
+// Step has a pointer receiver because it updates the particle in place.
+
+type Particle struct {
+	Pos, Vel Vertex
+	Mass     float64
+}
+
+// Step advances the particle's position by its velocity over dt seconds.
+func (p *Particle) Step(dt float64) {
+	p.Pos = p.Pos.Add(p.Vel.Scale(dt))
+}
+
+// Graceful shutdown: RunSimulation starts one goroutine per particle, each stepping its particle on a ticker
+// until ctx is done. It waits on a sync.WaitGroup before returning, so when it returns no goroutine is
+// still touching the particles and the caller may read them safely.
+// Each goroutine owns exactly one particle, so no mutex is needed.
+//
+// time.NewTicker panics on an interval that isn't positive, and a panic inside one of the goroutines
+// couldn't be recovered by the caller. So a dt that isn't positive, or that is too small to round to
+// at least a nanosecond, is rejected up front: RunSimulation returns at once without touching the particles.
+
+func RunSimulation(ctx context.Context, particles []*Particle, dt float64) {
+	interval := time.Duration(dt * float64(time.Second))
+	if !(dt > 0) || interval <= 0 {
+		return
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(len(particles))
+	for _, p := range particles {
+		go func(p *Particle) {
+			defer wg.Done()
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ticker.C:
+					p.Step(dt)
+				case <-ctx.Done():
+					return
+				}
+			}
+		}(p)
+	}
+	wg.Wait()
+}
+
+func DemoRunSimulation() {
+	before := runtime.NumGoroutine()
+	particles := []*Particle{
+		{Pos: Vertex{X: 0, Y: 0}, Vel: Vertex{X: 1, Y: 0}, Mass: 1},
+		{Pos: Vertex{X: 5, Y: 5}, Vel: Vertex{X: 0, Y: -2}, Mass: 2},
+		{Pos: Vertex{X: -3, Y: 1}, Vel: Vertex{X: 0.5, Y: 0.5}, Mass: 0.5},
+	}
+
+	// The exact number of ticks depends on the scheduler, so only check the direction each particle moved.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	RunSimulation(ctx, particles, 0.005)
+	fmt.Println("Stopped after roughly 50ms:", time.Since(start) >= 50*time.Millisecond)
+
+	fmt.Println("Particle 0 moved right:", particles[0].Pos.X > 0)
+	fmt.Println("Particle 1 moved down:", particles[1].Pos.Y < 5)
+
+	// wg.Done runs just before each goroutine exits, so give them a moment to finish unwinding.
+	for i := 0; i < 100 && runtime.NumGoroutine() > before; i++ {
+		time.Sleep(time.Millisecond)
+	}
+	fmt.Println("Goroutines leaked:", runtime.NumGoroutine()-before)
+}
+
+// ApplyForce changes the particle's velocity by the acceleration f/Mass over dt seconds (Newton's second law).
+func (p *Particle) ApplyForce(f Vertex, dt float64) {
+	p.Vel = p.Vel.Add(f.Scale(dt / p.Mass))
+}
+
+// A Spring joins two particles. Hooke's law says it pulls its ends together when stretched past RestLength
+// and pushes them apart when compressed, with a force proportional to the difference.
+// It holds pointers so that it always sees the particles' current positions.
+
+type Spring struct {
+	A, B       *Particle
+	RestLength float64
+	Stiffness  float64
+}
+
+// Force returns the forces the spring exerts on A and on B. They are equal and opposite.
+// If the ends coincide there's no direction to push along, so both forces are zero.
+func (s Spring) Force() (Vertex, Vertex) {
+	delta := s.B.Pos.Subtract(s.A.Pos)
+	dir, err := delta.Normalize()
+	if err != nil {
+		return Vertex{}, Vertex{}
+	}
+	onA := dir.Scale(s.Stiffness * (delta.Absolute() - s.RestLength))
+	return onA, Vertex{}.Subtract(onA)
+}
+
+func DemoSpring() {
+	a := &Particle{Pos: Vertex{X: 0, Y: 0}, Mass: 1}
+	b := &Particle{Pos: Vertex{X: 3, Y: 0}, Mass: 1}
+	spring := Spring{A: a, B: b, RestLength: 2, Stiffness: 4}
+
+	onA, onB := spring.Force()
+	fmt.Println("Stretched by 1, force on A:", onA, "force on B:", onB)
+
+	// Apply the forces first and then step (semi-implicit Euler), which keeps the oscillation stable.
+	// The separation swings back and forth around the rest length of 2.
+	const dt = 0.1
+	for i := range 12 {
+		onA, onB := spring.Force()
+		a.ApplyForce(onA, dt)
+		b.ApplyForce(onB, dt)
+		a.Step(dt)
+		b.Step(dt)
+		fmt.Printf("Step %2d: separation %.3f\n", i+1, b.Pos.Distance(a.Pos))
+	}
+}
@@ -0,0 +1,44 @@
+package methods
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestRunSimulationStopsOnCancel(t *testing.T) {
+	particles := []*Particle{
+		{Pos: Vertex{X: 0, Y: 0}, Vel: Vertex{X: 1, Y: 0}, Mass: 1},
+		{Pos: Vertex{X: 5, Y: 5}, Vel: Vertex{X: 0, Y: -2}, Mass: 2},
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+	defer cancel()
+
+	done := make(chan struct{})
+	go func() {
+		RunSimulation(ctx, particles, 0.001)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("RunSimulation did not return after its context was done")
+	}
+
+	// RunSimulation has returned, so its goroutines are finished and the particles are safe to read.
+	if particles[0].Pos.X <= 0 || particles[1].Pos.Y >= 5 {
+		t.Errorf("particles did not move along their velocities: %v, %v", particles[0].Pos, particles[1].Pos)
+	}
+}
+
+func TestRunSimulationRejectsBadTimeStep(t *testing.T) {
+	for _, dt := range []float64{0, -0.01, 1e-12, math.NaN(), math.Inf(-1)} {
+		p := &Particle{Pos: Vertex{X: 1, Y: 1}, Vel: Vertex{X: 1, Y: 0}, Mass: 1}
+		// A context that never ends: RunSimulation must return on its own rather than panic or block.
+		RunSimulation(context.Background(), []*Particle{p}, dt)
+		if p.Pos != (Vertex{X: 1, Y: 1}) {
+			t.Errorf("RunSimulation with dt=%v moved the particle to %v", dt, p.Pos)
+		}
+	}
+}
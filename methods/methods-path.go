@@ -0,0 +1,150 @@
+package methods
+
+import (
+	"fmt"
+	"math"
+)
+
+// DistanceToSegment returns the shortest distance from p to the segment ab.
+// p is projected onto the line through a and b, and the projection is clamped to the segment,
+// so a point beyond either end is measured to the nearest endpoint.
+// When a == b the segment is a single point and the result is simply the distance to it.
+func (p Vertex) DistanceToSegment(a, b Vertex) float64 {
+	ab := Vertex{X: b.X - a.X, Y: b.Y - a.Y}
+	lengthSquared := ab.Dot(ab)
+	if lengthSquared == 0 {
+		return p.Distance(a)
+	}
+	ap := Vertex{X: p.X - a.X, Y: p.Y - a.Y}
+	t := math.Max(0, math.Min(1, ap.Dot(ab)/lengthSquared))
+	return p.Distance(a.Lerp(b, t))
+}
+
+// SimplifyPath reduces a polyline with the Ramer-Douglas-Peucker algorithm.
+// It keeps the two endpoints, finds the point farthest from the segment between them,
+// and if that point is more than epsilon away it keeps it and simplifies each half recursively;
+// otherwise every point in between is dropped. Paths with fewer than 3 points are returned unchanged.
+// The result is always a new slice.
+//
+// A negative or NaN epsilon is treated as 0, which keeps every point that is off the line.
+// Without that, a straight run of points (whose farthest distance is 0) would never be "within epsilon",
+// and the recursion would split the path at its first point forever.
+func SimplifyPath(path []Vertex, epsilon float64) []Vertex {
+	if !(epsilon >= 0) {
+		epsilon = 0
+	}
+	if len(path) < 3 {
+		return append([]Vertex(nil), path...)
+	}
+
+	first, last := path[0], path[len(path)-1]
+	farthest, maxDistance := 0, 0.0
+	for i := 1; i < len(path)-1; i++ {
+		if d := path[i].DistanceToSegment(first, last); d > maxDistance {
+			farthest, maxDistance = i, d
+		}
+	}
+
+	if maxDistance <= epsilon {
+		return []Vertex{first, last}
+	}
+
+	left := SimplifyPath(path[:farthest+1], epsilon)
+	right := SimplifyPath(path[farthest:], epsilon)
+	// The farthest point ends the left half and starts the right one; keep it only once.
+	return append(left[:len(left)-1], right...)
+}
+
+func DemoDistanceToSegment() {
+	a, b := Vertex{X: 0, Y: 0}, Vertex{X: 4, Y: 0}
+
+	// Projects inside the segment: the closest point is Vertex(2, 0).
+	fmt.Println("Distance from Vertex(2, 3):", Vertex{X: 2, Y: 3}.DistanceToSegment(a, b))
+	// Projects past b: the closest point is the endpoint b itself.
+	fmt.Println("Distance from Vertex(7, 4):", Vertex{X: 7, Y: 4}.DistanceToSegment(a, b))
+	// A degenerate segment is just a point.
+	fmt.Println("Distance from Vertex(3, 4) to a point segment:", Vertex{X: 3, Y: 4}.DistanceToSegment(a, a))
+}
+
+func DemoSimplifyPath() {
+	nearlyStraight := []Vertex{{X: 0, Y: 0}, {X: 1, Y: 0.01}, {X: 2, Y: -0.02}, {X: 3, Y: 0.01}, {X: 4, Y: 0}}
+	fmt.Println("Near-straight path simplified:", SimplifyPath(nearlyStraight, 0.1))
+
+	corner := []Vertex{{X: 0, Y: 0}, {X: 1, Y: 0.05}, {X: 2, Y: 0}, {X: 2, Y: 1}, {X: 2.05, Y: 2}, {X: 2, Y: 3}}
+	fmt.Println("Corner path simplified:", SimplifyPath(corner, 0.1))
+
+	fmt.Println("Two-point path:", SimplifyPath([]Vertex{{X: 0, Y: 0}, {X: 1, Y: 1}}, 0.1))
+}
+
+// Windows returns every run of size consecutive vertices in vs, sliding forward one vertex at a time,
+// so a path of n vertices has n-size+1 windows. If size is larger than the path there are none.
+//
+// The windows are subslices of vs rather than copies, so they share its backing array.
+// Each is sliced with a capacity limit (vs[i:i+size:i+size]), so appending to a window
+// reallocates instead of overwriting the vertices that follow it in vs.
+func Windows(vs []Vertex, size int) ([][]Vertex, error) {
+	if size <= 0 {
+		return nil, fmt.Errorf("windows: size must be positive, got %d", size)
+	}
+	if size > len(vs) {
+		return [][]Vertex{}, nil
+	}
+
+	windows := make([][]Vertex, 0, len(vs)-size+1)
+	for i := 0; i+size <= len(vs); i++ {
+		windows = append(windows, vs[i:i+size:i+size])
+	}
+	return windows, nil
+}
+
+func DemoWindows() {
+	path := []Vertex{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 2, Y: 1}, {X: 2, Y: 3}, {X: 1, Y: 4}}
+	windows, err := Windows(path, 3)
+	if err != nil {
+		fmt.Println("Error:", err)
+		return
+	}
+	// Each window of three is a vertex with its two neighbours, which is what a local measure like curvature needs.
+	for i, w := range windows {
+		fmt.Println("Window", i, w)
+	}
+
+	windows, err = Windows(path, 6)
+	fmt.Println("Size larger than the path:", len(windows), err)
+	_, err = Windows(path, 0)
+	fmt.Println("Size 0:", err)
+}
+
+// TriangleArea returns the area of the triangle abc. The cross product of two edges is twice the signed area
+// (positive when a, b, c turn counter-clockwise), so half its absolute value is the area.
+func TriangleArea(a, b, c Vertex) float64 {
+	return math.Abs(b.Subtract(a).Cross(c.Subtract(a))) / 2
+}
+
+// Curvature estimates how sharply a path bends at b, given its neighbours a and c, using the Menger curvature:
+// 4·area(abc) / (|ab|·|bc|·|ca|). That is 1/R for the circle through all three points,
+// so straight (collinear) points give 0 and tight turns give large values.
+// If any two points coincide there is no single circle through them, and Curvature returns 0.
+func Curvature(a, b, c Vertex) float64 {
+	ab, bc, ca := a.Distance(b), b.Distance(c), c.Distance(a)
+	if ab == 0 || bc == 0 || ca == 0 {
+		return 0
+	}
+	return 4 * TriangleArea(a, b, c) / (ab * bc * ca)
+}
+
+func DemoCurvature() {
+	fmt.Println("Collinear:", Curvature(Vertex{X: 0, Y: 0}, Vertex{X: 1, Y: 1}, Vertex{X: 2, Y: 2}))
+	// Three points on a circle of radius 2 give curvature 1/2.
+	fmt.Printf("On a circle of radius 2: %.3f\n", Curvature(Vertex{X: 2, Y: 0}, Vertex{X: 0, Y: 2}, Vertex{X: -2, Y: 0}))
+	fmt.Printf("Gentle bend: %.3f\n", Curvature(Vertex{X: 0, Y: 0}, Vertex{X: 5, Y: 0.5}, Vertex{X: 10, Y: 0}))
+	fmt.Printf("Sharp corner: %.3f\n", Curvature(Vertex{X: 0, Y: 0}, Vertex{X: 1, Y: 0}, Vertex{X: 0.1, Y: 0.1}))
+	fmt.Println("Coincident points:", Curvature(Vertex{X: 1, Y: 1}, Vertex{X: 1, Y: 1}, Vertex{X: 3, Y: 0}))
+
+	// Sliding a window of three along a path gives the curvature at every interior vertex.
+	path := []Vertex{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 2, Y: 0}, {X: 3, Y: 1}, {X: 3, Y: 2}, {X: 2, Y: 2}}
+	windows, _ := Windows(path, 3)
+	for _, w := range windows {
+		fmt.Printf("Curvature at %v: %.3f\n", w[1], Curvature(w[0], w[1], w[2]))
+	}
+}
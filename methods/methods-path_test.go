@@ -0,0 +1,52 @@
+package methods
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestSimplifyPath(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    []Vertex
+		epsilon float64
+		want    []Vertex
+	}{
+		{"empty", nil, 0.1, []Vertex{}},
+		{"two points", []Vertex{{X: 0, Y: 0}, {X: 1, Y: 1}}, 0.1, []Vertex{{X: 0, Y: 0}, {X: 1, Y: 1}}},
+		{
+			"near-straight",
+			[]Vertex{{X: 0, Y: 0}, {X: 1, Y: 0.01}, {X: 2, Y: -0.02}, {X: 3, Y: 0.01}, {X: 4, Y: 0}},
+			0.1,
+			[]Vertex{{X: 0, Y: 0}, {X: 4, Y: 0}},
+		},
+		{
+			"corner",
+			[]Vertex{{X: 0, Y: 0}, {X: 1, Y: 0.05}, {X: 2, Y: 0}, {X: 2, Y: 1}, {X: 2.05, Y: 2}, {X: 2, Y: 3}},
+			0.1,
+			[]Vertex{{X: 0, Y: 0}, {X: 2, Y: 0}, {X: 2, Y: 3}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SimplifyPath(tt.path, tt.epsilon); !slices.Equal(got, tt.want) {
+				t.Errorf("SimplifyPath(%v, %v) = %v, want %v", tt.path, tt.epsilon, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSimplifyPathInvalidEpsilon(t *testing.T) {
+	collinear := []Vertex{{X: 0, Y: 0}, {X: 1, Y: 1}, {X: 2, Y: 2}, {X: 3, Y: 3}}
+	bent := []Vertex{{X: 0, Y: 0}, {X: 1, Y: 0.5}, {X: 2, Y: 0}}
+	for _, epsilon := range []float64{-1, math.NaN()} {
+		// These used to recurse without end on the collinear path.
+		if got, want := SimplifyPath(collinear, epsilon), []Vertex{{X: 0, Y: 0}, {X: 3, Y: 3}}; !slices.Equal(got, want) {
+			t.Errorf("SimplifyPath(collinear, %v) = %v, want %v", epsilon, got, want)
+		}
+		if got := SimplifyPath(bent, epsilon); !slices.Equal(got, bent) {
+			t.Errorf("SimplifyPath(bent, %v) = %v, want every point kept", epsilon, got)
+		}
+	}
+}
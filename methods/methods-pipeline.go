@@ -0,0 +1,87 @@
+package methods
+
+import (
+	"fmt"
+	"runtime"
+	"time"
+)
+
+// A pipeline is a series of stages connected by channels. Each stage is a goroutine that receives from upstream,
+// does its work, and sends downstream, closing its output channel when its input is exhausted,
+// so the close ripples down the pipeline and ends the consumer's range loop.
+//
+// If the consumer stops early, upstream stages would block forever on their sends.
+// Every stage therefore also selects on a shared done channel: closing done releases all of them at once.
+
+func GenerateVertices(done <-chan struct{}, vs ...Vertex) <-chan Vertex {
+	out := make(chan Vertex)
+	go func() {
+		defer close(out)
+		for _, v := range vs {
+			select {
+			case out <- v:
+			case <-done:
+				return
+			}
+		}
+	}()
+	return out
+}
+
+func ScaleVertices(done <-chan struct{}, in <-chan Vertex, f float64) <-chan Vertex {
+	out := make(chan Vertex)
+	go func() {
+		defer close(out)
+		for v := range in {
+			select {
+			case out <- v.Scale(f):
+			case <-done:
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// FilterByMagnitude passes on only the vertices whose magnitude is at least minMagnitude.
+func FilterByMagnitude(done <-chan struct{}, in <-chan Vertex, minMagnitude float64) <-chan Vertex {
+	out := make(chan Vertex)
+	go func() {
+		defer close(out)
+		for v := range in {
+			if v.Absolute() < minMagnitude {
+				continue
+			}
+			select {
+			case out <- v:
+			case <-done:
+				return
+			}
+		}
+	}()
+	return out
+}
+
+func DemoPipeline() {
+	input := []Vertex{{X: 1, Y: 0}, {X: 3, Y: 4}, {X: 0.5, Y: 0.5}, {X: 6, Y: 8}, {X: 2, Y: 2}}
+
+	done := make(chan struct{})
+	for v := range FilterByMagnitude(done, ScaleVertices(done, GenerateVertices(done, input...), 2), 5) {
+		fmt.Println("Pipeline output:", v)
+	}
+	close(done)
+
+	// Stop after the first result: closing done unblocks every stage still waiting to send.
+	before := runtime.NumGoroutine()
+	done = make(chan struct{})
+	out := FilterByMagnitude(done, ScaleVertices(done, GenerateVertices(done, input...), 2), 0)
+	fmt.Println("First result before cancelling:", <-out)
+	close(done)
+	for range out {
+		// Drain until the last stage closes its output.
+	}
+	for i := 0; i < 100 && runtime.NumGoroutine() > before; i++ {
+		time.Sleep(time.Millisecond)
+	}
+	fmt.Println("Goroutines leaked after cancelling:", runtime.NumGoroutine()-before)
+}
@@ -0,0 +1,87 @@
+package methods
+
+import "fmt"
+
+// There are two common ways to get a pointer to a new struct value.
+// new(T) allocates a zeroed T and returns a *T.
+// &T{...} takes the address of a composite literal, which lets us set fields at the same time.
+// Both give an ordinary *T, so both work with pointer-receiver methods.
+
+func DemoNewVsLiteral() {
+	p1 := new(Vertex)
+	p2 := &Vertex{X: 1}
+	fmt.Printf("new(Vertex): %v (%T)\n", *p1, p1)
+	fmt.Printf("&Vertex{X: 1}: %v (%T)\n", *p2, p2)
+
+	p1.ScaleWithPointer(3)
+	p2.ScaleWithPointer(3)
+	fmt.Println("After ScaleWithPointer(3):", *p1, *p2)
+
+	// new is handy when there is nothing to initialize, and for non-struct types where no literal exists.
+	p1.X, p1.Y = 3, 4
+	fmt.Println("new(Vertex) after setting fields:", *p1, p1.Absolute())
+}
+
+// A deferred call's function value and arguments are evaluated when the defer statement runs,
+// not when the call finally happens. The receiver counts as an argument:
+// defer v.ScaleWithValue(2) copies v right away, so it scales that snapshot (and the result is thrown away),
+// while defer v.ScaleWithPointer(2) evaluates &v, and scales whatever v holds by the time the function returns.
+// Named results let the deferred calls run against the value being returned.
+
+func deferredValueReceiver() (v Vertex) {
+	v = Vertex{X: 1, Y: 1}
+	defer v.ScaleWithValue(2) // receiver copied now: Vertex(1, 1)
+	v.X = 10
+	return v
+}
+
+func deferredPointerReceiver() (v Vertex) {
+	v = Vertex{X: 1, Y: 1}
+	defer v.ScaleWithPointer(2) // &v captured now; v is read when the deferred call runs
+	v.X = 10
+	return v
+}
+
+func DemoDeferReceiverCopy() {
+	fmt.Println("Deferred value-receiver call:", deferredValueReceiver())
+	fmt.Println("Deferred pointer-receiver call:", deferredPointerReceiver())
+}
+
+// Vertex is only 16 bytes, so copying it for a value receiver is free in practice.
+// BigVertex carries an 8 KiB payload ([1024]float64) alongside X and Y, so every value-receiver call
+// copies all 8 KiB in, and returning the scaled result copies it out again.
+// The pointer-receiver method passes a single 8-byte address instead.
+// BenchmarkBigVertexScaleWithValue and BenchmarkBigVertexScaleWithPointer in methods_bench_test.go measure the difference.
+
+type BigVertex struct {
+	X, Y    float64
+	Payload [1024]float64
+}
+
+// ScaleWithValue returns a scaled copy of b; b itself is unchanged.
+func (b BigVertex) ScaleWithValue(f float64) BigVertex {
+	b.X = b.X * f
+	b.Y = b.Y * f
+	return b
+}
+
+// ScaleWithPointer scales b in place.
+func (b *BigVertex) ScaleWithPointer(f float64) {
+	b.X = b.X * f
+	b.Y = b.Y * f
+}
+
+func DemoBigVertex() {
+	original := BigVertex{X: 3, Y: 4}
+	original.Payload[0] = 42
+
+	byValue := original.ScaleWithValue(2.5)
+	byPointer := original
+	byPointer.ScaleWithPointer(2.5)
+
+	fmt.Println("Original X, Y unchanged by ScaleWithValue:", original.X, original.Y)
+	fmt.Println("ScaleWithValue X, Y:", byValue.X, byValue.Y)
+	fmt.Println("ScaleWithPointer X, Y:", byPointer.X, byPointer.Y)
+	// Arrays are comparable, so == compares the whole struct, payload included.
+	fmt.Println("Both methods give the same result:", byValue == byPointer)
+}
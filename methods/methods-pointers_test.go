@@ -0,0 +1,26 @@
+package methods
+
+import "testing"
+
+func TestBigVertexScaleMethodsAgree(t *testing.T) {
+	original := BigVertex{X: 3, Y: -4}
+	original.Payload[0] = 42
+
+	byValue := original.ScaleWithValue(2.5)
+	byPointer := original
+	byPointer.ScaleWithPointer(2.5)
+
+	if byValue.X != 7.5 || byValue.Y != -10 {
+		t.Errorf("ScaleWithValue(2.5) gave X, Y = %v, %v, want 7.5, -10", byValue.X, byValue.Y)
+	}
+	if byValue.X != byPointer.X || byValue.Y != byPointer.Y {
+		t.Errorf("ScaleWithValue gave X, Y = %v, %v but ScaleWithPointer gave %v, %v",
+			byValue.X, byValue.Y, byPointer.X, byPointer.Y)
+	}
+	if byValue.Payload != original.Payload || byPointer.Payload != original.Payload {
+		t.Error("scaling changed the payload")
+	}
+	if original.X != 3 || original.Y != -4 {
+		t.Errorf("ScaleWithValue modified its receiver to X, Y = %v, %v", original.X, original.Y)
+	}
+}
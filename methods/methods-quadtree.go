@@ -0,0 +1,151 @@
+package methods
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// A quad-tree splits a square region into four quadrants whenever it holds too many points,
+// and splits those quadrants again as needed. A range query then skips every quadrant
+// whose bounds don't intersect the query box, instead of checking every point.
+//
+// Points on a boundary: a point exactly on a node's dividing lines goes to the east and/or north quadrant,
+// so every point belongs to exactly one leaf. Points on the outer edge of the root bounds are accepted.
+// Duplicates: identical points are all stored. Since they can never be separated by splitting,
+// nodes stop subdividing at quadTreeMaxDepth and simply hold more than capacity points.
+
+const quadTreeMaxDepth = 16
+
+type QuadTree struct {
+	bounds   AABB
+	capacity int
+	depth    int
+	points   []Vertex
+	children *[4]QuadTree // nil until the node subdivides
+}
+
+func NewQuadTree(bounds AABB, capacity int) *QuadTree {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &QuadTree{bounds: bounds, capacity: capacity}
+}
+
+// Insert adds v to the tree. It returns false if v lies outside the tree's bounds.
+func (q *QuadTree) Insert(v Vertex) bool {
+	if !q.bounds.Contains(v) {
+		return false
+	}
+	q.insert(v)
+	return true
+}
+
+func (q *QuadTree) insert(v Vertex) {
+	if q.children != nil {
+		q.child(v).insert(v)
+		return
+	}
+
+	q.points = append(q.points, v)
+	if len(q.points) > q.capacity && q.depth < quadTreeMaxDepth {
+		q.subdivide()
+	}
+}
+
+func (q *QuadTree) subdivide() {
+	lo, hi := q.bounds.Min, q.bounds.Max
+	mid := lo.Lerp(hi, 0.5)
+	q.children = &[4]QuadTree{
+		{bounds: AABB{Min: lo, Max: mid}},                                              // south-west
+		{bounds: AABB{Min: Vertex{X: mid.X, Y: lo.Y}, Max: Vertex{X: hi.X, Y: mid.Y}}}, // south-east
+		{bounds: AABB{Min: Vertex{X: lo.X, Y: mid.Y}, Max: Vertex{X: mid.X, Y: hi.Y}}}, // north-west
+		{bounds: AABB{Min: mid, Max: hi}},                                              // north-east
+	}
+	for i := range q.children {
+		q.children[i].capacity = q.capacity
+		q.children[i].depth = q.depth + 1
+	}
+
+	points := q.points
+	q.points = nil
+	for _, p := range points {
+		q.child(p).insert(p)
+	}
+}
+
+// child picks the quadrant for v; points on the dividing lines go east and/or north.
+func (q *QuadTree) child(v Vertex) *QuadTree {
+	mid := q.bounds.Min.Lerp(q.bounds.Max, 0.5)
+	i := 0
+	if v.X >= mid.X {
+		i++
+	}
+	if v.Y >= mid.Y {
+		i += 2
+	}
+	return &q.children[i]
+}
+
+// QueryRange returns every stored point inside r, boundary included.
+func (q *QuadTree) QueryRange(r AABB) []Vertex {
+	var found []Vertex
+	q.query(r, &found)
+	return found
+}
+
+func (q *QuadTree) query(r AABB, found *[]Vertex) {
+	if !q.bounds.Intersects(r) {
+		return
+	}
+	for _, p := range q.points {
+		if r.Contains(p) {
+			*found = append(*found, p)
+		}
+	}
+	if q.children != nil {
+		for i := range q.children {
+			q.children[i].query(r, found)
+		}
+	}
+}
+
+func DemoQuadTree() {
+	tree := NewQuadTree(AABB{Min: Vertex{X: 0, Y: 0}, Max: Vertex{X: 100, Y: 100}}, 4)
+
+	// A fixed seed keeps the demo reproducible.
+	rng := rand.New(rand.NewSource(42))
+	var all []Vertex
+	for range 200 {
+		v := Vertex{X: float64(rng.Intn(101)), Y: float64(rng.Intn(101))}
+		tree.Insert(v)
+		all = append(all, v)
+	}
+	// A duplicate, a point on the centre lines, and one outside the bounds.
+	tree.Insert(all[0])
+	all = append(all, all[0])
+	tree.Insert(Vertex{X: 50, Y: 50})
+	all = append(all, Vertex{X: 50, Y: 50})
+	fmt.Println("Insert outside bounds:", tree.Insert(Vertex{X: 150, Y: 10}))
+
+	region := AABB{Min: Vertex{X: 25, Y: 40}, Max: Vertex{X: 50, Y: 60}}
+	found := tree.QueryRange(region)
+
+	// Brute force: count matches per point and compare multisets.
+	expected := make(map[Vertex]int)
+	for _, v := range all {
+		if region.Contains(v) {
+			expected[v]++
+		}
+	}
+	for _, v := range found {
+		expected[v]--
+	}
+	matches := true
+	for _, n := range expected {
+		if n != 0 {
+			matches = false
+		}
+	}
+	fmt.Println("Points found in region:", len(found))
+	fmt.Println("Matches brute-force scan:", matches)
+}
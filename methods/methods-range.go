@@ -0,0 +1,30 @@
+package methods
+
+import (
+	"fmt"
+	"math"
+)
+
+// Since Go 1.22, range accepts an integer: `for i := range n` runs i from 0 to n-1.
+// It replaces the classic three-clause loop when all we need is a counter,
+// and requires the go directive in go.mod to be 1.22 or later.
+
+// CirclePoints returns n vertices evenly spaced on a circle of the given radius around the origin,
+// starting on the positive X axis. If n is not positive there are no points, and it returns nil.
+func CirclePoints(n int, radius float64) []Vertex {
+	if n <= 0 {
+		return nil
+	}
+	points := make([]Vertex, 0, n)
+	for i := range n {
+		angle := 2 * math.Pi * float64(i) / float64(n)
+		points = append(points, Vertex{X: radius * math.Cos(angle), Y: radius * math.Sin(angle)})
+	}
+	return points
+}
+
+func DemoRangeOverInt() {
+	for i, p := range CirclePoints(8, 1) {
+		fmt.Printf("Point %d: (%.3f, %.3f) magnitude %.3f\n", i, p.X, p.Y, p.Absolute())
+	}
+}
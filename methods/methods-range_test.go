@@ -0,0 +1,28 @@
+package methods
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCirclePoints(t *testing.T) {
+	const epsilon = 1e-9
+	points := CirclePoints(4, 2)
+	want := []Vertex{{X: 2, Y: 0}, {X: 0, Y: 2}, {X: -2, Y: 0}, {X: 0, Y: -2}}
+	if len(points) != len(want) {
+		t.Fatalf("CirclePoints(4, 2) returned %d points, want %d", len(points), len(want))
+	}
+	for i, p := range points {
+		if math.Abs(p.X-want[i].X) > epsilon || math.Abs(p.Y-want[i].Y) > epsilon {
+			t.Errorf("point %d = %v, want %v", i, p, want[i])
+		}
+	}
+}
+
+func TestCirclePointsNonPositiveCount(t *testing.T) {
+	for _, n := range []int{0, -1, -100} {
+		if got := CirclePoints(n, 1); got != nil {
+			t.Errorf("CirclePoints(%d, 1) = %v, want nil", n, got)
+		}
+	}
+}
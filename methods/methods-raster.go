@@ -0,0 +1,71 @@
+package methods
+
+import (
+	"fmt"
+	"math"
+)
+
+// Bresenham's line algorithm picks the grid cells that best approximate a straight line
+// using only integer additions and comparisons, which is why it was the standard way to draw lines on early displays.
+//
+// It steps one cell at a time along the line and keeps a running error term: how far the ideal line
+// has drifted from the cell it's currently on. Whenever the error crosses half a cell, it also steps along the
+// other axis. This "all octants" form handles every slope and direction without special cases.
+
+// maxRasterCoordinate bounds the coordinates RasterizeLine accepts. Converting a float64 outside the int range
+// to int gives an implementation-specific value, and keeping every coordinate within ±2²⁹
+// also keeps x1-x0 and y1-y0 from overflowing even where int is 32 bits.
+const maxRasterCoordinate = 1 << 29
+
+// RasterizeLine returns the grid cells from a to b (both rounded to the nearest integer), in order and including both ends.
+// A line whose ends round to the same cell yields just that cell.
+// If any coordinate is NaN, infinite or larger in magnitude than 2²⁹ there is no line to draw, and it returns nil.
+func RasterizeLine(a, b Vertex) []Vertex {
+	for _, c := range []float64{a.X, a.Y, b.X, b.Y} {
+		// !(|c| <= max) rather than |c| > max, so that NaN is rejected too.
+		if !(math.Abs(c) <= maxRasterCoordinate) {
+			return nil
+		}
+	}
+
+	x0, y0 := int(math.Round(a.X)), int(math.Round(a.Y))
+	x1, y1 := int(math.Round(b.X)), int(math.Round(b.Y))
+
+	dx, sx := x1-x0, 1
+	if dx < 0 {
+		dx, sx = -dx, -1
+	}
+	dy, sy := y1-y0, 1
+	if dy < 0 {
+		dy, sy = -dy, -1
+	}
+
+	cells := make([]Vertex, 0, max(dx, dy)+1)
+	// err is the drift scaled by dx and dy; doubling it into e2 compares against half a cell without fractions.
+	err := dx - dy
+	for {
+		cells = append(cells, Vertex{X: float64(x0), Y: float64(y0)})
+		if x0 == x1 && y0 == y1 {
+			return cells
+		}
+		e2 := 2 * err
+		if e2 > -dy {
+			err -= dy
+			x0 += sx
+		}
+		if e2 < dx {
+			err += dx
+			y0 += sy
+		}
+	}
+}
+
+func DemoRasterizeLine() {
+	fmt.Println("Diagonal:", RasterizeLine(Vertex{X: 0, Y: 0}, Vertex{X: 3, Y: 3}))
+	fmt.Println("Shallow:", RasterizeLine(Vertex{X: 0, Y: 0}, Vertex{X: 5, Y: 2}))
+	fmt.Println("Steep:", RasterizeLine(Vertex{X: 0, Y: 0}, Vertex{X: 1, Y: 4}))
+	fmt.Println("Horizontal, right to left:", RasterizeLine(Vertex{X: 3, Y: 1}, Vertex{X: 0, Y: 1}))
+	fmt.Println("Vertical:", RasterizeLine(Vertex{X: 2, Y: 0}, Vertex{X: 2, Y: -3}))
+	fmt.Println("Single point (ends round together):", RasterizeLine(Vertex{X: 1.2, Y: 0.9}, Vertex{X: 0.8, Y: 1.4}))
+	fmt.Println("NaN end (no cells):", RasterizeLine(Vertex{X: 0, Y: 0}, Vertex{X: math.NaN(), Y: 1}))
+}
@@ -0,0 +1,37 @@
+package methods
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestRasterizeLine(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b Vertex
+		want []Vertex
+	}{
+		{"diagonal", Vertex{X: 0, Y: 0}, Vertex{X: 2, Y: 2}, []Vertex{{X: 0, Y: 0}, {X: 1, Y: 1}, {X: 2, Y: 2}}},
+		{"vertical, downwards", Vertex{X: 2, Y: 0}, Vertex{X: 2, Y: -2}, []Vertex{{X: 2, Y: 0}, {X: 2, Y: -1}, {X: 2, Y: -2}}},
+		{"single cell", Vertex{X: 1.2, Y: 0.9}, Vertex{X: 0.8, Y: 1.4}, []Vertex{{X: 1, Y: 1}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RasterizeLine(tt.a, tt.b); !slices.Equal(got, tt.want) {
+				t.Errorf("RasterizeLine(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRasterizeLineRejectsBadCoordinates(t *testing.T) {
+	for _, c := range []float64{math.NaN(), math.Inf(1), math.Inf(-1), 1e300, -2 * maxRasterCoordinate} {
+		if cells := RasterizeLine(Vertex{}, Vertex{X: c, Y: 1}); cells != nil {
+			t.Errorf("RasterizeLine to X = %v returned %d cells, want nil", c, len(cells))
+		}
+		if cells := RasterizeLine(Vertex{X: 1, Y: c}, Vertex{}); cells != nil {
+			t.Errorf("RasterizeLine from Y = %v returned %d cells, want nil", c, len(cells))
+		}
+	}
+}
@@ -0,0 +1,223 @@
+package methods
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// A struct tag is a string literal attached to a field, like `json:"x" desc:"x-coordinate"`.
+// Tags have no effect on their own; packages read them at run time through reflection.
+// By convention a tag is a space-separated list of key:"value" pairs, which reflect.StructTag.Get parses.
+
+// DescribeTags prints the desc tag of every field of a struct (or pointer to struct).
+func DescribeTags(i interface{}) {
+	t := reflect.TypeOf(i)
+	if t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		fmt.Printf("%T is not a struct\n", i)
+		return
+	}
+
+	for n := 0; n < t.NumField(); n++ {
+		field := t.Field(n)
+		desc, ok := field.Tag.Lookup("desc")
+		if !ok {
+			desc = "(no description)"
+		}
+		fmt.Printf("%s.%s: %s\n", t.Name(), field.Name, desc)
+	}
+}
+
+func DemoStructTags() {
+	DescribeTags(Vertex{X: 3, Y: 4})
+	DescribeTags(&Coordinate{X: 3, Y: 4})
+	DescribeTags(42)
+}
+
+// reflect.DeepEqual compares floats exactly. DeepAlmostEqual walks two values the same way,
+// but treats float fields as equal when they are within epsilon of each other.
+//
+// It follows structs, pointers, interfaces, arrays and slices, and compares booleans, integers and strings exactly.
+// Unexported struct fields are compared too: reflect can read them through Float, Int and friends
+// even though calling Interface on them would panic. Maps, channels, functions and complex numbers are not supported
+// and always compare unequal. To stay safe on cyclic data such as a self-referencing pointer,
+// the walk gives up (returning false) below deepAlmostEqualMaxDepth levels of nesting.
+
+const deepAlmostEqualMaxDepth = 32
+
+func DeepAlmostEqual(a, b interface{}, epsilon float64) bool {
+	return deepAlmostEqual(reflect.ValueOf(a), reflect.ValueOf(b), epsilon, 0)
+}
+
+func deepAlmostEqual(a, b reflect.Value, epsilon float64, depth int) bool {
+	if !a.IsValid() || !b.IsValid() {
+		return a.IsValid() == b.IsValid()
+	}
+	if a.Type() != b.Type() || depth > deepAlmostEqualMaxDepth {
+		return false
+	}
+
+	switch a.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return math.Abs(a.Float()-b.Float()) <= epsilon
+	case reflect.Bool:
+		return a.Bool() == b.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() == b.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return a.Uint() == b.Uint()
+	case reflect.String:
+		return a.String() == b.String()
+	case reflect.Ptr, reflect.Interface:
+		if a.IsNil() || b.IsNil() {
+			return a.IsNil() == b.IsNil()
+		}
+		return deepAlmostEqual(a.Elem(), b.Elem(), epsilon, depth+1)
+	case reflect.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			if !deepAlmostEqual(a.Field(i), b.Field(i), epsilon, depth+1) {
+				return false
+			}
+		}
+		return true
+	case reflect.Slice:
+		if a.IsNil() != b.IsNil() {
+			return false
+		}
+		fallthrough
+	case reflect.Array:
+		if a.Len() != b.Len() {
+			return false
+		}
+		for i := 0; i < a.Len(); i++ {
+			if !deepAlmostEqual(a.Index(i), b.Index(i), epsilon, depth+1) {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
+
+func DemoDeepAlmostEqual() {
+	x, y := 0.1, 0.2
+	type labelledPath struct {
+		Name   string
+		Points []Vertex
+		Origin *Coordinate
+		weight float64
+	}
+
+	a := labelledPath{Name: "route", Points: []Vertex{{X: x + y, Y: 1}}, Origin: &Coordinate{X: x + y}, weight: x + y}
+	b := labelledPath{Name: "route", Points: []Vertex{{X: 0.3, Y: 1}}, Origin: &Coordinate{X: 0.3}, weight: 0.3}
+
+	fmt.Println("reflect.DeepEqual:", reflect.DeepEqual(a, b))
+	fmt.Println("DeepAlmostEqual:", DeepAlmostEqual(a, b, 1e-9))
+
+	b.Name = "detour"
+	fmt.Println("DeepAlmostEqual with a different name:", DeepAlmostEqual(a, b, 1e-9))
+	fmt.Println("DeepAlmostEqual on Vertex values:", DeepAlmostEqual(Vertex{X: 1}, Vertex{X: 1.0000001}, 1e-3))
+}
+
+// MustImplement checks at run time that T implements Absoluteness, and panics with a clear message if not.
+// reflect.TypeOf((*T)(nil)).Elem() yields T's type even when T is itself an interface or a pointer type,
+// where reflect.TypeOf on a zero T would not.
+//
+// A compile-time assertion such as `var _ Absoluteness = MyFloat(0)` is almost always better:
+// it costs nothing at run time and a mistake stops the build instead of crashing a running program.
+// A run-time check is only useful when the type isn't known until then, for example in plugin or registry code.
+func MustImplement[T any]() {
+	iface := reflect.TypeOf((*Absoluteness)(nil)).Elem()
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	if !t.Implements(iface) {
+		panic(fmt.Sprintf("%v does not implement %v (%s)", t, iface, whyNotImplements(t, iface)))
+	}
+}
+
+// whyNotImplements names the first method of iface that t lacks, in the same words the compiler uses.
+// A method declared on *T is in the method set of *T but not of T, which is reported separately
+// because the fix is to pass a pointer rather than to add a method.
+func whyNotImplements(t, iface reflect.Type) string {
+	for i := 0; i < iface.NumMethod(); i++ {
+		want := iface.Method(i)
+		got, ok := t.MethodByName(want.Name)
+		if !ok {
+			if t.Kind() != reflect.Interface && t.Kind() != reflect.Pointer {
+				if _, ok := reflect.PointerTo(t).MethodByName(want.Name); ok {
+					return fmt.Sprintf("method %s has pointer receiver", want.Name)
+				}
+			}
+			return fmt.Sprintf("missing method %s", want.Name)
+		}
+		// For a concrete type the method's Type includes the receiver as its first parameter; drop it to compare.
+		gotType := got.Type
+		if t.Kind() != reflect.Interface {
+			gotType = methodSignature(got.Type)
+		}
+		if gotType != want.Type {
+			return fmt.Sprintf("wrong type for method %s: have %v, want %v", want.Name, gotType, want.Type)
+		}
+	}
+	return "unknown reason"
+}
+
+// methodSignature returns the func type of fn without its first parameter, the receiver.
+func methodSignature(fn reflect.Type) reflect.Type {
+	in := make([]reflect.Type, fn.NumIn()-1)
+	for i := range in {
+		in[i] = fn.In(i + 1)
+	}
+	out := make([]reflect.Type, fn.NumOut())
+	for i := range out {
+		out[i] = fn.Out(i)
+	}
+	return reflect.FuncOf(in, out, fn.IsVariadic())
+}
+
+func DemoMustImplement() {
+	check := func(name string, f func()) {
+		defer func() {
+			if r := recover(); r != nil {
+				fmt.Println(name, "panicked:", r)
+			}
+		}()
+		f()
+		fmt.Println(name, "ok")
+	}
+
+	check("MustImplement[MyFloat]", MustImplement[MyFloat])
+	check("MustImplement[*Coordinate]", MustImplement[*Coordinate])
+	check("MustImplement[Coordinate]", MustImplement[Coordinate])
+}
+
+// Func values are only comparable to nil. A struct with a func field can't be compared with == at all
+// (the compiler rejects it), and reflect.DeepEqual doesn't panic on one but reports two non-nil funcs
+// as unequal, even the very same function. So two AnimatedVertex values are never DeepEqual
+// once Easing is set, however alike they are.
+//
+// AnimatedVertex.Equal compares what equality should mean here, the position, and ignores the func.
+
+type AnimatedVertex struct {
+	Vertex
+	Easing func(t float64) float64
+}
+
+func (a AnimatedVertex) Equal(other AnimatedVertex) bool {
+	return a.Vertex == other.Vertex
+}
+
+func DemoDeepEqualFuncFields() {
+	linear := func(t float64) float64 { return t }
+	a := AnimatedVertex{Vertex: Vertex{X: 3, Y: 4}, Easing: linear}
+	b := AnimatedVertex{Vertex: Vertex{X: 3, Y: 4}, Easing: linear}
+	// fmt.Println(a == b) would not compile: invalid operation: struct containing func cannot be compared.
+
+	fmt.Println("DeepEqual on plain Vertex values:", reflect.DeepEqual(a.Vertex, b.Vertex))
+	fmt.Println("DeepEqual with the same non-nil func:", reflect.DeepEqual(a, b))
+	fmt.Println("DeepEqual with both funcs nil:", reflect.DeepEqual(AnimatedVertex{Vertex: a.Vertex}, AnimatedVertex{Vertex: b.Vertex}))
+	fmt.Println("Custom Equal ignoring the func:", a.Equal(b))
+}
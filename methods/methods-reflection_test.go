@@ -0,0 +1,46 @@
+package methods
+
+import (
+	"fmt"
+	"testing"
+)
+
+type intAbs int
+
+func (i intAbs) Abs() int {
+	if i < 0 {
+		return int(-i)
+	}
+	return int(i)
+}
+
+func mustImplementPanic(f func()) (msg string) {
+	defer func() {
+		if r := recover(); r != nil {
+			msg = fmt.Sprint(r)
+		}
+	}()
+	f()
+	return ""
+}
+
+func TestMustImplement(t *testing.T) {
+	tests := []struct {
+		name string
+		f    func()
+		want string
+	}{
+		{"implements", MustImplement[MyFloat], ""},
+		{"pointer implements", MustImplement[*Coordinate], ""},
+		{"pointer receiver", MustImplement[Coordinate], "methods.Coordinate does not implement methods.Absoluteness (method Abs has pointer receiver)"},
+		{"missing method", MustImplement[Vertex], "methods.Vertex does not implement methods.Absoluteness (missing method Abs)"},
+		{"wrong type", MustImplement[intAbs], "methods.intAbs does not implement methods.Absoluteness (wrong type for method Abs: have func() int, want func() float64)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mustImplementPanic(tt.f); got != tt.want {
+				t.Errorf("panic message = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package methods
+
+import "fmt"
+
+// Go normally returns (value, error) pairs and checks err after every step.
+// Result[T] bundles the pair into one value so steps can be chained,
+// with each step skipped once an earlier one has failed.
+// Methods can't declare their own type parameters, so Map keeps the type T;
+// MapResult is a plain generic function for steps that change the type.
+
+type Result[T any] struct {
+	Value T
+	Err   error
+}
+
+func Ok[T any](v T) Result[T] {
+	return Result[T]{Value: v}
+}
+
+func Err[T any](err error) Result[T] {
+	return Result[T]{Err: err}
+}
+
+// Wrap turns a (value, error) pair, as returned by most Go functions, into a Result.
+func Wrap[T any](v T, err error) Result[T] {
+	if err != nil {
+		return Err[T](err)
+	}
+	return Ok(v)
+}
+
+// Map applies f to the value, or passes the error along untouched.
+func (r Result[T]) Map(f func(T) T) Result[T] {
+	if r.Err != nil {
+		return r
+	}
+	return Ok(f(r.Value))
+}
+
+func MapResult[T, U any](r Result[T], f func(T) U) Result[U] {
+	if r.Err != nil {
+		return Err[U](r.Err)
+	}
+	return Ok(f(r.Value))
+}
+
+func DemoResult() {
+	double := func(v Vertex) Vertex { return Vertex{X: 2 * v.X, Y: 2 * v.Y} }
+	for _, input := range []string{"(3, 4)", "3;4", "(x, 4)"} {
+		r := MapResult(Wrap(ParseVertex(input)).Map(double), Vertex.Absolute)
+		if r.Err != nil {
+			fmt.Printf("%q -> error: %v\n", input, r.Err)
+			continue
+		}
+		fmt.Printf("%q -> magnitude of doubled vertex: %v\n", input, r.Value)
+	}
+}
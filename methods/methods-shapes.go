@@ -0,0 +1,98 @@
+package methods
+
+import (
+	"fmt"
+	"math"
+)
+
+// Shape is a small interface shared by a few concrete geometric types.
+// Any type with an Area method satisfies it, with no "implements" declaration needed.
+
+type Shape interface {
+	Area() float64
+}
+
+type Rectangle struct {
+	Width, Height float64
+}
+
+func (r Rectangle) Area() float64 {
+	return r.Width * r.Height
+}
+
+type Circle struct {
+	Radius float64
+}
+
+func (c Circle) Area() float64 {
+	return math.Pi * c.Radius * c.Radius
+}
+
+// TotalArea sums the areas of a mixed slice of shapes.
+// A nil element is a nil interface value with no concrete type, so calling Area on it would panic; it is skipped.
+// Note that this check only catches nil interfaces: a Shape holding a nil *Rectangle is non-nil,
+// and calling Area on it still dereferences the nil pointer.
+func TotalArea(shapes []Shape) float64 {
+	total := 0.0
+	for _, s := range shapes {
+		if s == nil {
+			continue
+		}
+		total += s.Area()
+	}
+	return total
+}
+
+func DemoTotalArea() {
+	shapes := []Shape{
+		Rectangle{Width: 2, Height: 3},
+		nil,
+		Circle{Radius: 1},
+		Rectangle{Width: 1, Height: 1},
+	}
+	fmt.Println("Total area (nil skipped):", TotalArea(shapes))
+}
+
+// A registry maps names to factory functions, so new Shape implementations can plug themselves in
+// without the code that creates shapes knowing about them. This is the pattern behind database/sql drivers
+// and image format decoders: each implementation registers itself from an init function,
+// which Go runs automatically when the package is loaded.
+
+var shapeFactories = make(map[string]func() Shape)
+
+// RegisterShape makes a factory available under name. Like sql.Register,
+// it panics if factory is nil or the name is already taken, since both are programming errors.
+func RegisterShape(name string, factory func() Shape) {
+	if factory == nil {
+		panic("methods: RegisterShape factory is nil")
+	}
+	if _, dup := shapeFactories[name]; dup {
+		panic("methods: RegisterShape called twice for " + name)
+	}
+	shapeFactories[name] = factory
+}
+
+// NewShape creates a shape using the factory registered under name.
+func NewShape(name string) (Shape, error) {
+	factory, ok := shapeFactories[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown shape %q", name)
+	}
+	return factory(), nil
+}
+
+func init() {
+	RegisterShape("rectangle", func() Shape { return Rectangle{Width: 1, Height: 1} })
+	RegisterShape("circle", func() Shape { return Circle{Radius: 1} })
+}
+
+func DemoShapeRegistry() {
+	for _, name := range []string{"rectangle", "circle", "hexagon"} {
+		s, err := NewShape(name)
+		if err != nil {
+			fmt.Println("NewShape error:", err)
+			continue
+		}
+		fmt.Printf("NewShape(%q): %T with area %v\n", name, s, s.Area())
+	}
+}
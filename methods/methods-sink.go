@@ -0,0 +1,72 @@
+package methods
+
+import "fmt"
+
+// io.Writer is a one-method interface, and much of the standard library is built by wrapping one Writer in another
+// (bufio.Writer, gzip.Writer, ...). VertexSink follows the same shape for vertices.
+//
+// BufferedSink embeds the VertexSink it wraps, so it is a VertexSink itself. It defines its own Write,
+// which shadows the promoted one, and reaches the wrapped sink explicitly through the embedded field.
+
+type VertexSink interface {
+	Write(v Vertex) error
+}
+
+// SliceSink appends every vertex it is given to Vertices.
+type SliceSink struct {
+	Vertices []Vertex
+}
+
+func (s *SliceSink) Write(v Vertex) error {
+	s.Vertices = append(s.Vertices, v)
+	return nil
+}
+
+// BufferedSink holds up to size vertices before passing them on,
+// like bufio.Writer. Callers must call Flush when done, or the buffered vertices are lost.
+type BufferedSink struct {
+	VertexSink
+	size   int
+	buffer []Vertex
+}
+
+func NewBufferedSink(sink VertexSink, size int) *BufferedSink {
+	return &BufferedSink{VertexSink: sink, size: size}
+}
+
+func (b *BufferedSink) Write(v Vertex) error {
+	b.buffer = append(b.buffer, v)
+	if len(b.buffer) >= b.size {
+		return b.Flush()
+	}
+	return nil
+}
+
+// Flush writes every buffered vertex to the wrapped sink.
+// If a write fails, the vertices not yet written stay buffered.
+func (b *BufferedSink) Flush() error {
+	for i, v := range b.buffer {
+		if err := b.VertexSink.Write(v); err != nil {
+			b.buffer = b.buffer[i:]
+			return err
+		}
+	}
+	b.buffer = b.buffer[:0]
+	return nil
+}
+
+// A compile-time check that the decorator satisfies the interface it decorates.
+var _ VertexSink = (*BufferedSink)(nil)
+
+func DemoBufferedSink() {
+	dest := &SliceSink{}
+	var sink VertexSink = NewBufferedSink(dest, 3)
+
+	for i := 1; i <= 4; i++ {
+		sink.Write(Vertex{X: float64(i), Y: float64(i)})
+		fmt.Printf("After write %d, destination holds %d vertices\n", i, len(dest.Vertices))
+	}
+
+	sink.(*BufferedSink).Flush()
+	fmt.Println("After Flush:", dest.Vertices)
+}
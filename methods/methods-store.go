@@ -0,0 +1,69 @@
+package methods
+
+import (
+	"fmt"
+	"sync"
+)
+
+// A map is not safe for concurrent use if any goroutine writes to it.
+// sync.Mutex lets one goroutine at a time in; sync.RWMutex distinguishes readers from writers:
+// any number of goroutines may hold the read lock together, while the write lock is exclusive.
+// That pays off when reads vastly outnumber writes.
+
+type VertexStore struct {
+	mu       sync.RWMutex
+	vertices map[string]Vertex
+}
+
+func NewVertexStore() *VertexStore {
+	return &VertexStore{vertices: make(map[string]Vertex)}
+}
+
+func (s *VertexStore) Get(name string) (Vertex, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	v, ok := s.vertices[name]
+	return v, ok
+}
+
+func (s *VertexStore) Set(name string, v Vertex) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.vertices[name] = v
+}
+
+func (s *VertexStore) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.vertices)
+}
+
+func DemoVertexStore() {
+	store := NewVertexStore()
+
+	var wg sync.WaitGroup
+	for w := range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 100 {
+				store.Set(fmt.Sprintf("w%d-%d", w, i), Vertex{X: float64(w), Y: float64(i)})
+			}
+		}()
+	}
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 100 {
+				store.Get(fmt.Sprintf("w0-%d", i))
+			}
+		}()
+	}
+	wg.Wait()
+
+	// Run with `go run -race .` to confirm the concurrent access is race-free.
+	v, ok := store.Get("w3-42")
+	fmt.Println("Stored vertices:", store.Len())
+	fmt.Println("w3-42:", v, ok)
+}
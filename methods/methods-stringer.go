@@ -0,0 +1,78 @@
+package methods
+
+import (
+	"fmt"
+	"strings"
+)
+
+// String makes Vertex a fmt.Stringer, so Println and %v print Vertex(3, 4) instead of {3 4}.
+func (v Vertex) String() string {
+	return fmt.Sprintf("Vertex(%v, %v)", v.X, v.Y)
+}
+
+// String on *Coordinate follows the nil-receiver pattern of Coordinate.Abs:
+// a nil pointer is a valid receiver, and prints as "<nil>" instead of panicking.
+// Because the receiver is a pointer, only *Coordinate values are Stringers; a plain Coordinate still prints as {x y}.
+func (v *Coordinate) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Coordinate(%v, %v)", v.X, v.Y)
+}
+
+// fmt looks for a few well-known interfaces on the values it prints.
+// For the %v and %s verbs (and Println), it checks for error before fmt.Stringer:
+// if a value implements both, its Error method wins and String is never called.
+// %d, %f and friends don't use either method; %#v uses GoString if present.
+
+type BoundsViolation struct {
+	Point Vertex
+	Limit float64
+}
+
+func (b BoundsViolation) String() string {
+	return fmt.Sprintf("BoundsViolation at %v", b.Point)
+}
+
+func (b BoundsViolation) Error() string {
+	return fmt.Sprintf("vertex %v is outside the limit %v", b.Point, b.Limit)
+}
+
+func DemoStringerVsError() {
+	b := BoundsViolation{Point: Vertex{X: 12, Y: 3}, Limit: 10}
+
+	// Both of these print the Error() text.
+	fmt.Printf("%%v: %v\n", b)
+	fmt.Printf("%%s: %s\n", b)
+
+	// To get the String() text, call it explicitly.
+	fmt.Println("String():", b.String())
+
+	// %+v still goes through Error(); only formatting the fields directly avoids both methods.
+	fmt.Printf("%%+v: %+v\n", b)
+	fmt.Printf("Fields: %v %v\n", b.Point, b.Limit)
+}
+
+// Strings are immutable, so s += t copies all of s into a new string every time:
+// building a long string that way costs time proportional to the square of its length.
+// strings.Builder appends into a growable byte buffer instead, copying each piece once.
+
+// FormatPath joins the String forms of vs with " -> ".
+func FormatPath(vs []Vertex) string {
+	var b strings.Builder
+	for i, v := range vs {
+		if i > 0 {
+			b.WriteString(" -> ")
+		}
+		b.WriteString(v.String())
+	}
+	return b.String()
+}
+
+func DemoFormatPath() {
+	fmt.Println("Short path:", FormatPath([]Vertex{{X: 0, Y: 0}, {X: 1, Y: 2}, {X: 3, Y: 4}}))
+
+	long := CirclePoints(1000, 100)
+	formatted := FormatPath(long)
+	fmt.Println("1000-point path length in bytes:", len(formatted))
+}
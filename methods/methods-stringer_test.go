@@ -0,0 +1,36 @@
+package methods
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestVertexString(t *testing.T) {
+	tests := []struct {
+		v    Vertex
+		want string
+	}{
+		{Vertex{X: 3, Y: 4}, "Vertex(3, 4)"},
+		{Vertex{}, "Vertex(0, 0)"},
+		{Vertex{X: -1.5, Y: 0.25}, "Vertex(-1.5, 0.25)"},
+	}
+	for _, tt := range tests {
+		if got := fmt.Sprintf("%v", tt.v); got != tt.want {
+			t.Errorf("fmt.Sprintf(%%v) = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestCoordinateString(t *testing.T) {
+	if got, want := (&Coordinate{X: 3, Y: 4}).String(), "Coordinate(3, 4)"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+
+	var c *Coordinate
+	if got, want := c.String(), "<nil>"; got != want {
+		t.Errorf("String() on a nil *Coordinate = %q, want %q", got, want)
+	}
+	if got, want := fmt.Sprint(c), "<nil>"; got != want {
+		t.Errorf("fmt.Sprint of a nil *Coordinate = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,90 @@
+package methods
+
+import "math"
+
+// Treating a Vertex as a 2D vector gives us a handful of useful value-receiver methods.
+// None of them modify the receiver, so they all take a Vertex by value and return a new result.
+
+// Add returns the component-wise sum of v and other.
+func (v Vertex) Add(other Vertex) Vertex {
+	return Vertex{X: v.X + other.X, Y: v.Y + other.Y}
+}
+
+// Subtract returns the component-wise difference v - other.
+func (v Vertex) Subtract(other Vertex) Vertex {
+	return Vertex{X: v.X - other.X, Y: v.Y - other.Y}
+}
+
+// Scale returns v with both components multiplied by f.
+// Unlike ScaleWithValue and ScaleWithPointer it returns the result, so it can be chained: v.Scale(2).Add(w).
+func (v Vertex) Scale(f float64) Vertex {
+	return Vertex{X: v.X * f, Y: v.Y * f}
+}
+
+// Dot returns the dot product of v and other: the sum of the products of their components.
+// The dot product of a vector with itself is the square of its magnitude, v.Dot(v) == v.Absolute()²,
+// and anything dotted with the zero vertex is 0.
+func (v Vertex) Dot(other Vertex) float64 {
+	return v.X*other.X + v.Y*other.Y
+}
+
+// Cross returns the z component of the 3D cross product of v and other.
+// Its sign tells us whether other lies counter-clockwise (positive) or clockwise (negative) from v.
+func (v Vertex) Cross(other Vertex) float64 {
+	return v.X*other.Y - v.Y*other.X
+}
+
+// Distance returns the Euclidean distance between v and other:
+// the magnitude of the vector from one to the other, built from Subtract and Absolute.
+func (v Vertex) Distance(other Vertex) float64 {
+	return v.Subtract(other).Absolute()
+}
+
+// Lerp linearly interpolates from v to other: t=0 gives v, t=1 gives other.
+func (v Vertex) Lerp(other Vertex, t float64) Vertex {
+	return Vertex{
+		X: v.X + (other.X-v.X)*t,
+		Y: v.Y + (other.Y-v.Y)*t,
+	}
+}
+
+// ManhattanDistance returns |dx| + |dy|, the number of unit steps between v and other
+// when only horizontal and vertical moves are allowed.
+func (v Vertex) ManhattanDistance(other Vertex) float64 {
+	return math.Abs(v.X-other.X) + math.Abs(v.Y-other.Y)
+}
+
+// Equals reports whether v and other differ by at most epsilon in both components.
+// Comparing floats with a tolerance rather than == absorbs rounding error; an epsilon of 0 asks for exact equality.
+func (v Vertex) Equals(other Vertex, epsilon float64) bool {
+	return math.Abs(v.X-other.X) <= epsilon && math.Abs(v.Y-other.Y) <= epsilon
+}
+
+// AbsoluteHypot computes the same magnitude as Absolute using math.Hypot.
+// Absolute squares each component first, so components above about 1e154 overflow to +Inf
+// even when the magnitude itself is representable. Hypot rescales internally to avoid that,
+// at the cost of a little extra work per call.
+func (v Vertex) AbsoluteHypot() float64 {
+	return math.Hypot(v.X, v.Y)
+}
+
+// Rotate returns v rotated counter-clockwise around the origin by the given angle in radians,
+// using the rotation matrix [cos -sin; sin cos].
+func (v Vertex) Rotate(radians float64) Vertex {
+	sin, cos := math.Sincos(radians)
+	return Vertex{
+		X: v.X*cos - v.Y*sin,
+		Y: v.X*sin + v.Y*cos,
+	}
+}
+
+// Normalize returns the unit vector pointing the same way as v.
+// The zero vertex has no direction, and dividing by its zero magnitude would produce NaN components,
+// so it returns ErrZeroVector instead.
+func (v Vertex) Normalize() (Vertex, error) {
+	m := v.Absolute()
+	if m == 0 {
+		return Vertex{}, ErrZeroVector
+	}
+	return Vertex{X: v.X / m, Y: v.Y / m}, nil
+}
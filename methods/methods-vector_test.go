@@ -0,0 +1,245 @@
+package methods
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+	"testing/quick"
+)
+
+// Property-based testing checks that a statement holds for many randomly generated inputs,
+// instead of for a few hand-picked examples. testing/quick calls a function with random arguments
+// and reports the first input for which it returns false.
+//
+// quick would happily generate float64s up to about 1.8e308, whose squares overflow to +Inf.
+// A type implementing quick.Generator controls its own generation, so boundedVertex keeps components in [-1000, 1000].
+
+type boundedVertex Vertex
+
+func (boundedVertex) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(boundedVertex{X: r.Float64()*2000 - 1000, Y: r.Float64()*2000 - 1000})
+}
+
+// A fixed seed makes any failure reproducible.
+func quickConfig() *quick.Config {
+	return &quick.Config{MaxCount: 1000, Rand: rand.New(rand.NewSource(1))}
+}
+
+func TestDistanceIsSymmetric(t *testing.T) {
+	symmetric := func(a, b boundedVertex) bool {
+		return Vertex(a).Distance(Vertex(b)) == Vertex(b).Distance(Vertex(a))
+	}
+	if err := quick.Check(symmetric, quickConfig()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDistanceIsNonNegative(t *testing.T) {
+	nonNegative := func(a, b boundedVertex) bool {
+		return Vertex(a).Distance(Vertex(b)) >= 0
+	}
+	if err := quick.Check(nonNegative, quickConfig()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDistanceTriangleInequality(t *testing.T) {
+	const epsilon = 1e-9
+	// d(a,c) <= d(a,b) + d(b,c), allowing a little slack for rounding.
+	triangle := func(a, b, c boundedVertex) bool {
+		ab, bc, ac := Vertex(a).Distance(Vertex(b)), Vertex(b).Distance(Vertex(c)), Vertex(a).Distance(Vertex(c))
+		return ac <= ab+bc+epsilon*math.Max(1, ab+bc)
+	}
+	if err := quick.Check(triangle, quickConfig()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAbsoluteHypotLargeComponents(t *testing.T) {
+	v := Vertex{X: 3e200, Y: 4e200}
+	if got := v.Absolute(); !math.IsInf(got, 1) {
+		t.Fatalf("Absolute() = %v, expected the squares to overflow to +Inf", got)
+	}
+	got := v.AbsoluteHypot()
+	if math.IsInf(got, 0) || math.Abs(got-5e200) > 5e200*1e-15 {
+		t.Errorf("AbsoluteHypot() = %v, want 5e200", got)
+	}
+	if got, want := (Vertex{X: 3, Y: 4}).AbsoluteHypot(), 5.0; got != want {
+		t.Errorf("AbsoluteHypot() of (3, 4) = %v, want %v", got, want)
+	}
+}
+
+func TestAddSubtract(t *testing.T) {
+	tests := []struct {
+		name       string
+		v, other   Vertex
+		sum, delta Vertex
+	}{
+		{"both zero", Vertex{}, Vertex{}, Vertex{}, Vertex{}},
+		{"zero other", Vertex{X: 3, Y: 4}, Vertex{}, Vertex{X: 3, Y: 4}, Vertex{X: 3, Y: 4}},
+		{"zero receiver", Vertex{}, Vertex{X: 3, Y: 4}, Vertex{X: 3, Y: 4}, Vertex{X: -3, Y: -4}},
+		{"positive", Vertex{X: 3, Y: 4}, Vertex{X: 1, Y: 2}, Vertex{X: 4, Y: 6}, Vertex{X: 2, Y: 2}},
+		{"negative components", Vertex{X: -3, Y: 4}, Vertex{X: 1, Y: -2}, Vertex{X: -2, Y: 2}, Vertex{X: -4, Y: 6}},
+		{"opposites", Vertex{X: -1, Y: -1}, Vertex{X: 1, Y: 1}, Vertex{}, Vertex{X: -2, Y: -2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.v.Add(tt.other); got != tt.sum {
+				t.Errorf("%v.Add(%v) = %v, want %v", tt.v, tt.other, got, tt.sum)
+			}
+			if got := tt.v.Subtract(tt.other); got != tt.delta {
+				t.Errorf("%v.Subtract(%v) = %v, want %v", tt.v, tt.other, got, tt.delta)
+			}
+		})
+	}
+}
+
+func TestDotWithItselfIsMagnitudeSquared(t *testing.T) {
+	const epsilon = 1e-9
+	for _, v := range []Vertex{{}, {X: 3, Y: 4}, {X: -1.5, Y: 2.25}, {X: 0.1, Y: 0.2}, {X: 1e3, Y: -7}} {
+		got, want := v.Dot(v), v.Absolute()*v.Absolute()
+		if math.Abs(got-want) > epsilon*math.Max(1, want) {
+			t.Errorf("%v.Dot(itself) = %v, want Absolute()² = %v", v, got, want)
+		}
+	}
+}
+
+func TestDot(t *testing.T) {
+	tests := []struct {
+		v, other Vertex
+		want     float64
+	}{
+		{Vertex{}, Vertex{}, 0},
+		{Vertex{X: 3, Y: 4}, Vertex{}, 0},
+		{Vertex{X: 1, Y: 0}, Vertex{X: 0, Y: 1}, 0},
+		{Vertex{X: 3, Y: 4}, Vertex{X: -1, Y: 2}, 5},
+	}
+	for _, tt := range tests {
+		if got := tt.v.Dot(tt.other); got != tt.want {
+			t.Errorf("%v.Dot(%v) = %v, want %v", tt.v, tt.other, got, tt.want)
+		}
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	const epsilon = 1e-12
+	for _, v := range []Vertex{{X: 3, Y: 4}, {X: -2, Y: 0}, {X: 1e-3, Y: -1e-3}} {
+		unit, err := v.Normalize()
+		if err != nil {
+			t.Fatalf("%v.Normalize() returned error %v", v, err)
+		}
+		if math.Abs(unit.Absolute()-1) > epsilon {
+			t.Errorf("%v.Normalize() = %v with length %v, want length 1", v, unit, unit.Absolute())
+		}
+		// Same direction: the unit vector scaled back up is v again.
+		if back := unit.Scale(v.Absolute()); !back.Equals(v, epsilon) {
+			t.Errorf("%v.Normalize() = %v does not point along v", v, unit)
+		}
+	}
+}
+
+func TestNormalizeZeroVector(t *testing.T) {
+	unit, err := Vertex{}.Normalize()
+	if err == nil {
+		t.Fatalf("Normalize() of the zero vertex = %v, want an error", unit)
+	}
+	if !errors.Is(err, ErrZeroVector) {
+		t.Errorf("Normalize() of the zero vertex returned %v, want ErrZeroVector", err)
+	}
+	if unit != (Vertex{}) {
+		t.Errorf("Normalize() of the zero vertex = %v, want the zero vertex alongside the error", unit)
+	}
+}
+
+func TestEquals(t *testing.T) {
+	const epsilon = 1e-9
+	a, b := 0.1, 0.2
+	tests := []struct {
+		name     string
+		v, other Vertex
+		want     bool
+	}{
+		{"exactly equal", Vertex{X: 3, Y: 4}, Vertex{X: 3, Y: 4}, true},
+		{"near equal", Vertex{X: a + b, Y: 0}, Vertex{X: 0.3, Y: 0}, true},
+		{"X outside tolerance", Vertex{X: 3, Y: 4}, Vertex{X: 3 + 1e-6, Y: 4}, false},
+		{"Y outside tolerance", Vertex{X: 3, Y: 4}, Vertex{X: 3, Y: 4 - 1e-6}, false},
+		{"exactly epsilon apart", Vertex{X: 0, Y: 0}, Vertex{X: epsilon, Y: 0}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.v.Equals(tt.other, epsilon); got != tt.want {
+				t.Errorf("%v.Equals(%v, %v) = %v, want %v", tt.v, tt.other, epsilon, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEqualsZeroEpsilon(t *testing.T) {
+	v := Vertex{X: 0.1, Y: -2.5}
+	if !v.Equals(v, 0) {
+		t.Errorf("%v.Equals(itself, 0) = false, want true", v)
+	}
+	if w := (Vertex{X: v.X, Y: math.Nextafter(v.Y, 0)}); v.Equals(w, 0) {
+		t.Errorf("%v.Equals(%v, 0) = true, want false", v, w)
+	}
+}
+
+func TestDistance(t *testing.T) {
+	tests := []struct {
+		a, b Vertex
+		want float64
+	}{
+		{Vertex{X: 0, Y: 0}, Vertex{X: 3, Y: 4}, 5},
+		{Vertex{X: 1, Y: 1}, Vertex{X: 1, Y: 1}, 0},
+		{Vertex{X: -1, Y: -1}, Vertex{X: 2, Y: 3}, 5},
+	}
+	for _, tt := range tests {
+		ab, ba := tt.a.Distance(tt.b), tt.b.Distance(tt.a)
+		if ab != tt.want {
+			t.Errorf("%v.Distance(%v) = %v, want %v", tt.a, tt.b, ab, tt.want)
+		}
+		if ab != ba {
+			t.Errorf("Distance is not commutative: %v.Distance(%v) = %v but %v.Distance(%v) = %v", tt.a, tt.b, ab, tt.b, tt.a, ba)
+		}
+	}
+}
+
+func TestRotate(t *testing.T) {
+	const epsilon = 1e-9
+	tests := []struct {
+		v       Vertex
+		radians float64
+		want    Vertex
+	}{
+		{Vertex{X: 1, Y: 0}, math.Pi / 2, Vertex{X: 0, Y: 1}},
+		{Vertex{X: 1, Y: 0}, -math.Pi / 2, Vertex{X: 0, Y: -1}},
+		{Vertex{X: 3, Y: 4}, math.Pi, Vertex{X: -3, Y: -4}},
+		{Vertex{X: 3, Y: 4}, 2 * math.Pi, Vertex{X: 3, Y: 4}},
+		{Vertex{X: 1, Y: 1}, math.Pi / 4, Vertex{X: 0, Y: math.Sqrt2}},
+		{Vertex{}, 1, Vertex{}},
+	}
+	for _, tt := range tests {
+		// Rounding means the result is rarely exact (Rotate(π/2) of (1, 0) gives X = 6.1e-17), so compare with a tolerance.
+		if got := tt.v.Rotate(tt.radians); !got.Equals(tt.want, epsilon) {
+			t.Errorf("%v.Rotate(%v) = %v, want %v", tt.v, tt.radians, got, tt.want)
+		}
+	}
+}
+
+func TestCross(t *testing.T) {
+	tests := []struct {
+		v, other Vertex
+		want     float64
+	}{
+		{Vertex{X: 1, Y: 0}, Vertex{X: 0, Y: 1}, 1},
+		{Vertex{X: 0, Y: 1}, Vertex{X: 1, Y: 0}, -1},
+		{Vertex{X: 2, Y: 2}, Vertex{X: 3, Y: 3}, 0},
+	}
+	for _, tt := range tests {
+		if got := tt.v.Cross(tt.other); got != tt.want {
+			t.Errorf("%v.Cross(%v) = %v, want %v", tt.v, tt.other, got, tt.want)
+		}
+	}
+}
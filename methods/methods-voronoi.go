@@ -0,0 +1,63 @@
+package methods
+
+import (
+	"fmt"
+	"strings"
+)
+
+// A Voronoi diagram splits the plane into one cell per site, each cell holding the points closer to that site
+// than to any other. We don't need to build the cells to use them:
+// finding which cell a point belongs to is just finding its nearest site.
+
+// NearestSite returns the index of the site closest to p. Ties go to the lower index.
+// ok is false when there are no sites.
+func NearestSite(p Vertex, sites []Vertex) (int, bool) {
+	if len(sites) == 0 {
+		return 0, false
+	}
+	nearest := 0
+	for i := 1; i < len(sites); i++ {
+		if p.Distance(sites[i]) < p.Distance(sites[nearest]) {
+			nearest = i
+		}
+	}
+	return nearest, true
+}
+
+// ClassifyGrid returns the nearest-site index for every point in grid, or -1 for every point if there are no sites.
+func ClassifyGrid(sites []Vertex, grid []Vertex) []int {
+	classes := make([]int, len(grid))
+	for i, p := range grid {
+		if site, ok := NearestSite(p, sites); ok {
+			classes[i] = site
+		} else {
+			classes[i] = -1
+		}
+	}
+	return classes
+}
+
+func DemoVoronoi() {
+	sites := []Vertex{{X: 1, Y: 1}, {X: 8, Y: 2}, {X: 4, Y: 7}}
+
+	const size = 10
+	var grid []Vertex
+	for y := size - 1; y >= 0; y-- {
+		for x := 0; x < size; x++ {
+			grid = append(grid, Vertex{X: float64(x), Y: float64(y)})
+		}
+	}
+
+	// Print the grid top row first, with A, B and C marking cells of sites 0, 1 and 2.
+	classes := ClassifyGrid(sites, grid)
+	for row := 0; row < size; row++ {
+		var b strings.Builder
+		for _, c := range classes[row*size : (row+1)*size] {
+			b.WriteByte(byte('A' + c))
+		}
+		fmt.Println(b.String())
+	}
+
+	_, ok := NearestSite(Vertex{}, nil)
+	fmt.Println("NearestSite with no sites ok:", ok)
+}
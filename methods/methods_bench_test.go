@@ -0,0 +1,363 @@
+package methods
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+// Run these with `go test -bench=. ./methods`; add -benchmem to report allocations for all of them.
+// Results go into package-level sink variables so the compiler can't discard the work being measured.
+
+var (
+	benchSinkFloat  float64
+	benchSinkAny    interface{}
+	benchSinkVertex Vertex
+)
+
+// Dynamic dispatch: calling a method through an interface value means loading the method's address
+// from the interface's method table and making an indirect call, which also stops the compiler from inlining it.
+// Vertex has an Absolute method rather than Abs, so it doesn't satisfy Absoluteness; *Coordinate does,
+// and calling its Abs directly and through the interface isolates the dispatch cost.
+// The indirect call itself costs well under a nanosecond, so BenchmarkDirectCoordinateAbs and BenchmarkInterfaceAbs
+// come out close (Coordinate.Abs contains a fmt call, so it isn't inlined even when called directly).
+// The big gap is against BenchmarkDirectAbs: Vertex.Absolute is small enough to be inlined into the loop,
+// so losing inlining, not the dispatch, is the real cost of going through an interface.
+// That only matters in very hot loops; everywhere else interfaces are effectively free.
+
+// absolutenessSink is a package-level variable, so the compiler can't prove its dynamic type and devirtualize the call.
+var absolutenessSink Absoluteness = &Coordinate{X: 3, Y: 4}
+
+func BenchmarkDirectAbs(b *testing.B) {
+	v := Vertex{X: 3, Y: 4}
+	for i := 0; i < b.N; i++ {
+		benchSinkFloat = v.Absolute()
+	}
+}
+
+func BenchmarkDirectCoordinateAbs(b *testing.B) {
+	c := &Coordinate{X: 3, Y: 4}
+	for i := 0; i < b.N; i++ {
+		benchSinkFloat = c.Abs()
+	}
+}
+
+func BenchmarkInterfaceAbs(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		benchSinkFloat = absolutenessSink.Abs()
+	}
+}
+
+// Absolute is a multiply-add and a square root; math.Hypot also checks for special values and rescales,
+// so expect BenchmarkAbsoluteHypot to be a few times slower than BenchmarkDirectAbs, which times Absolute.
+// Prefer it whenever components may be very large (or very small).
+
+func BenchmarkAbsoluteHypot(b *testing.B) {
+	v := Vertex{X: 3, Y: 4}
+	for i := 0; i < b.N; i++ {
+		benchSinkFloat = v.AbsoluteHypot()
+	}
+}
+
+// Scaling every element touches all the data in both layouts, so for this particular operation
+// the two come out close; the Go compiler doesn't auto-vectorize either loop.
+// The struct-of-arrays layout pays off when a loop only needs some of the fields
+// (for example summing just the Xs reads half as much memory) or when handing data to SIMD or GPU code.
+
+// Both benchmarks scale by -1 so the values stay bounded however many iterations run.
+
+const batchSize = 4096
+
+func BenchmarkScaleArrayOfStructs(b *testing.B) {
+	vs := make([]Vertex, batchSize)
+	for i := range vs {
+		vs[i] = Vertex{X: float64(i), Y: float64(i)}
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j := range vs {
+			vs[j].ScaleWithPointer(-1)
+		}
+	}
+}
+
+func BenchmarkScaleStructOfArrays(b *testing.B) {
+	vs := make([]Vertex, batchSize)
+	for i := range vs {
+		vs[i] = Vertex{X: float64(i), Y: float64(i)}
+	}
+	batch := NewVertexBatch(vs)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		batch.ScaleAll(-1)
+	}
+}
+
+// Converting a Vertex to interface{} (as calling DescribeGeneric does) "boxes" it: an interface value
+// is a type pointer plus a data pointer, and a 16-byte struct doesn't fit in a pointer, so it gets copied to the heap.
+// Expect BoxedVertex to report 1 allocs/op and 16 B/op, while ConcreteVertex reports 0.
+// The compiler avoids the allocation when it can prove the interface value doesn't escape,
+// which is why the results are stored in package-level sinks here.
+// Boxing a pointer such as *Vertex never allocates, since the pointer itself goes in the data word.
+
+func BenchmarkBoxedVertex(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchSinkAny = Vertex{X: float64(i), Y: 1}
+	}
+}
+
+func BenchmarkConcreteVertex(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchSinkVertex = Vertex{X: float64(i), Y: 1}
+	}
+}
+
+// FormatPath with strings.Builder against the same join written with +=.
+// Each += allocates a new string and copies everything built so far, so for a 1000-point path
+// expect the concatenation version to take many times longer and allocate orders of magnitude more bytes.
+
+var benchSinkString string
+
+func formatPathConcat(vs []Vertex) string {
+	s := ""
+	for i, v := range vs {
+		if i > 0 {
+			s += " -> "
+		}
+		s += v.String()
+	}
+	return s
+}
+
+func BenchmarkFormatPathBuilder(b *testing.B) {
+	path := CirclePoints(1000, 100)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchSinkString = FormatPath(path)
+	}
+}
+
+func BenchmarkFormatPathConcat(b *testing.B) {
+	path := CirclePoints(1000, 100)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchSinkString = formatPathConcat(path)
+	}
+}
+
+// VertexStore against the same store guarded by a plain sync.Mutex, with 99 reads for every write
+// spread over all CPUs (b.RunParallel). With a Mutex the readers queue up behind each other;
+// with an RWMutex they proceed together, so expect the RWMutex version to scale better as GOMAXPROCS grows.
+// On one or two CPUs, or with short critical sections, RWMutex's extra bookkeeping can make it no faster or even slower.
+
+type mutexVertexStore struct {
+	mu       sync.Mutex
+	vertices map[string]Vertex
+}
+
+func (s *mutexVertexStore) Get(name string) (Vertex, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v, ok := s.vertices[name]
+	return v, ok
+}
+
+func (s *mutexVertexStore) Set(name string, v Vertex) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.vertices[name] = v
+}
+
+type vertexGetSetter interface {
+	Get(name string) (Vertex, bool)
+	Set(name string, v Vertex)
+}
+
+func benchmarkReadHeavy(b *testing.B, store vertexGetSetter) {
+	store.Set("origin", Vertex{})
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			if i%100 == 0 {
+				store.Set("origin", Vertex{X: float64(i)})
+			} else {
+				store.Get("origin")
+			}
+			i++
+		}
+	})
+}
+
+func BenchmarkReadHeavyRWMutex(b *testing.B) {
+	benchmarkReadHeavy(b, NewVertexStore())
+}
+
+func BenchmarkReadHeavyMutex(b *testing.B) {
+	benchmarkReadHeavy(b, &mutexVertexStore{vertices: make(map[string]Vertex)})
+}
+
+// Generic vs interface-based code over the same 1000 MyFloat values.
+// Go compiles generic functions per "GC shape" and passes method information in a dictionary,
+// so calling Abs inside SumMagnitudes is still an indirect call: expect the two loops to run at a similar speed.
+// The real difference is the data: SumMagnitudes reads the []MyFloat directly,
+// while the interface version needs a []Absoluteness, and building it from a []MyFloat
+// boxes every element (1000 allocs/op in the "with conversion" benchmark).
+// Prefer generics for homogeneous collections of a known type; prefer interfaces when a collection
+// genuinely mixes types, or when the same code must work with values decided at run time.
+
+func magnitudeInputs() []MyFloat {
+	floats := make([]MyFloat, 1000)
+	for i := range floats {
+		floats[i] = MyFloat(i - 500)
+	}
+	return floats
+}
+
+func BenchmarkSumMagnitudesGeneric(b *testing.B) {
+	floats := magnitudeInputs()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchSinkFloat = SumMagnitudes(floats)
+	}
+}
+
+func BenchmarkSumMagnitudesIface(b *testing.B) {
+	floats := magnitudeInputs()
+	items := make([]Absoluteness, len(floats))
+	for i, f := range floats {
+		items[i] = f
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchSinkFloat = SumMagnitudesIface(items)
+	}
+}
+
+func BenchmarkSumMagnitudesIfaceWithConversion(b *testing.B) {
+	floats := magnitudeInputs()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		items := make([]Absoluteness, len(floats))
+		for j, f := range floats {
+			items[j] = f
+		}
+		benchSinkFloat = SumMagnitudesIface(items)
+	}
+}
+
+// Appending to a slice without spare capacity allocates a bigger backing array and copies everything over.
+// append grows the capacity geometrically (doubling for small slices, about 1.25x for large ones),
+// so the total copying is still amortized O(1) per element, but building 10000 vertices from an empty slice
+// takes around 20 allocations and copies. When the final length is known,
+// make([]Vertex, 0, n) allocates once: expect 1 allocs/op and noticeably fewer ns/op and B/op.
+
+const growthSize = 10000
+
+var benchSinkVertices []Vertex
+
+func BenchmarkAppendNoPrealloc(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var vs []Vertex
+		for j := 0; j < growthSize; j++ {
+			vs = append(vs, Vertex{X: float64(j), Y: float64(j)})
+		}
+		benchSinkVertices = vs
+	}
+}
+
+func BenchmarkAppendPrealloc(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		vs := make([]Vertex, 0, growthSize)
+		for j := 0; j < growthSize; j++ {
+			vs = append(vs, Vertex{X: float64(j), Y: float64(j)})
+		}
+		benchSinkVertices = vs
+	}
+}
+
+// Value vs pointer receivers: the intro says pointer receivers avoid copying the receiver on every call.
+// For a Vertex that copy is just two float64s (16 bytes), which costs next to nothing.
+// Expect ScaleWithValue to look faster anyway, at well under a nanosecond: it scales a copy that is then thrown away,
+// so once it's inlined the compiler drops the work entirely. That's the bug from the intro showing up as a benchmark.
+// ScaleWithPointer really does update v each time, and each multiplication waits for the previous one,
+// so it takes a few nanoseconds. For a struct this small the receiver choice is about semantics, not speed;
+// the copy only starts to cost something once the struct is much bigger than a few words (see BigVertex).
+
+func BenchmarkScaleWithValue(b *testing.B) {
+	v := Vertex{X: 3, Y: 4}
+	for i := 0; i < b.N; i++ {
+		v.ScaleWithValue(1.0000001)
+	}
+	benchSinkVertex = v
+}
+
+func BenchmarkScaleWithPointer(b *testing.B) {
+	v := Vertex{X: 3, Y: 4}
+	for i := 0; i < b.N; i++ {
+		v.ScaleWithPointer(1.0000001)
+	}
+	benchSinkVertex = v
+}
+
+// BigVertex is the same comparison with an 8 KiB struct, and here the copies dominate.
+// On the machine this was written on, ScaleWithValue took about 140 ns/op, almost all of it spent copying
+// the payload into the receiver and back out as the result, while ScaleWithPointer stayed at a few ns/op,
+// the same as for a small Vertex. Neither allocates: the copies live on the stack.
+
+var benchSinkBigVertex BigVertex
+
+func BenchmarkBigVertexScaleWithValue(b *testing.B) {
+	v := BigVertex{X: 3, Y: 4}
+	for i := 0; i < b.N; i++ {
+		v = v.ScaleWithValue(1.0000001)
+	}
+	benchSinkBigVertex = v
+}
+
+func BenchmarkBigVertexScaleWithPointer(b *testing.B) {
+	v := BigVertex{X: 3, Y: 4}
+	for i := 0; i < b.N; i++ {
+		v.ScaleWithPointer(1.0000001)
+	}
+	benchSinkBigVertex = v
+}
+
+// Channel buffering: with an unbuffered channel every send waits for a matching receive,
+// so producer and consumer hand over each Vertex in lockstep, and each handoff may mean a goroutine switch.
+// A buffer lets the producer run ahead and the consumer drain several values in a row,
+// so the switches happen once per batch instead of once per value. On the machine this was written on,
+// each op (one Vertex sent and received) took about 240 ns with buffer=0 (unbuffered), no less with a buffer of 1
+// (which still forces a switch almost every value), about 50 ns with 64, and no better with 1024:
+// once batches are large the per-value channel cost is all that's left.
+//
+// Buffering helps when the producer and consumer run at uneven speeds or in bursts. It does not help
+// when one side is simply slower on average: the buffer fills (or empties) and everyone waits anyway.
+// It also hides backpressure, so pick a size for a reason rather than "big, just in case".
+
+func BenchmarkChannelThroughput(b *testing.B) {
+	for _, size := range []int{0, 1, 64, 1024} {
+		b.Run(fmt.Sprintf("buffer=%d", size), func(b *testing.B) {
+			ch := make(chan Vertex, size)
+			go func() {
+				for i := 0; i < b.N; i++ {
+					ch <- Vertex{X: float64(i), Y: 1}
+				}
+				close(ch)
+			}()
+			var last Vertex
+			for v := range ch {
+				last = v
+			}
+			benchSinkVertex = last
+		})
+	}
+}